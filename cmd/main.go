@@ -1,5 +1,19 @@
 package main
 
-func main() {
+import (
+	"log"
+	"os"
+
+	"github.com/Eanhain/gophkeeper-client/configs"
+	"github.com/Eanhain/gophkeeper-client/internal/tui/theme"
+)
 
+func main() {
+	cfg, err := configs.NewConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := theme.Setup(cfg.UI.Theme, cfg.UI.ThemesFile, cfg.UI.NoColor); err != nil {
+		log.Fatal(err)
+	}
 }
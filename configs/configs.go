@@ -1,10 +1,29 @@
 package configs
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
+	"golang.org/x/term"
+
+	"github.com/Eanhain/gophkeeper-client/internal/crypto"
+)
+
+// Build metadata, injected at build time:
+//
+//	go build -ldflags "-X github.com/Eanhain/gophkeeper-client/configs.buildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ) \
+//	  -X github.com/Eanhain/gophkeeper-client/configs.gitCommit=$(git rev-parse HEAD)"
+var (
+	buildDate string
+	gitCommit string
 )
 
 type (
@@ -15,17 +34,21 @@ type (
 		Log     Log
 		Swagger Swagger
 		Crypto  Crypto
+		Binary  Binary
+		UI      UI
 	}
 
 	// App -.
 	App struct {
-		Name    string `env:"APP_NAME,required"`
-		Version string `env:"APP_VERSION,required"`
+		Name      string `env:"APP_NAME,required"`
+		Version   string `env:"APP_VERSION,required"`
+		BuildDate string
+		Commit    string
 	}
 
 	// HTTP -.
 	HTTP struct {
-		Host string `env:HTTP_HOST,required`
+		Host string `env:"HTTP_HOST,required"`
 		Port string `env:"HTTP_PORT,required"`
 	}
 
@@ -46,18 +69,199 @@ type (
 
 	// Crypto -.
 	Crypto struct {
-		Key string `env:"CRYPTO_KEY,required"`
+		Key         string `env:"CRYPTO_KEY"`
+		KeyFile     string `env:"CRYPTO_KEY_FILE"`
+		Interactive bool
+
+		ArgonTime    uint32 `env:"ARGON_TIME" envDefault:"1"`
+		ArgonMemory  uint32 `env:"ARGON_MEMORY" envDefault:"65536"`
+		ArgonThreads uint8  `env:"ARGON_THREADS" envDefault:"4"`
+	}
+
+	// Binary -.
+	Binary struct {
+		MaxSizeMB int64 `env:"BINARY_MAX_SIZE_MB" envDefault:"10"`
+	}
+
+	// UI -.
+	UI struct {
+		NoColor    bool   `env:"GOPHKEEPER_NO_COLOR"`
+		DateFormat string `env:"UI_DATE_FORMAT" envDefault:"2006-01-02 15:04"`
+		Theme      string `env:"UI_THEME"`
+		ThemesFile string `env:"UI_THEMES_FILE"`
 	}
 )
 
-// NewConfig returns app config.
-func NewConfig() (*Config, error) {
+// NewConfig returns app config. args are the command line flags without the
+// program name, usually os.Args[1:]; they override env values.
+func NewConfig(args []string) (*Config, error) {
 	cfg := &Config{}
 	godotenv.Load("./.env")
 	godotenv.Load("../../.env")
 	if err := env.Parse(cfg); err != nil {
 		return nil, fmt.Errorf("config error: %w", err)
 	}
+	// https://no-color.org: any non-empty NO_COLOR disables colors.
+	if os.Getenv("NO_COLOR") != "" {
+		cfg.UI.NoColor = true
+	}
+	if err := parseFlags(cfg, args); err != nil {
+		return nil, fmt.Errorf("config error: %w", err)
+	}
+	if err := loadCryptoKey(&cfg.Crypto); err != nil {
+		return nil, fmt.Errorf("config error: %w", err)
+	}
+	cfg.App.BuildDate = buildDate
+	cfg.App.Commit = gitCommit
+	if err := Validate(cfg); err != nil {
+		return nil, fmt.Errorf("config error: %w", err)
+	}
 
 	return cfg, nil
 }
+
+// Validate checks config values that env and flag parsing accept but the app cannot use.
+func Validate(cfg *Config) error {
+	if port, err := strconv.Atoi(cfg.HTTP.Port); err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("HTTP_PORT must be a number between 1 and 65535, got %q", cfg.HTTP.Port)
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Log.Level)); err != nil {
+		return fmt.Errorf("LOG_LEVEL must be debug, info, warn or error, got %q", cfg.Log.Level)
+	}
+	if cfg.Binary.MaxSizeMB <= 0 {
+		return fmt.Errorf("BINARY_MAX_SIZE_MB must be positive, got %d", cfg.Binary.MaxSizeMB)
+	}
+	if cfg.Crypto.ArgonTime < 1 {
+		return errors.New("ARGON_TIME must be at least 1")
+	}
+	if cfg.Crypto.ArgonMemory < 8192 {
+		return fmt.Errorf("ARGON_MEMORY must be at least 8192 KiB, got %d", cfg.Crypto.ArgonMemory)
+	}
+	if cfg.Crypto.ArgonThreads < 1 {
+		return errors.New("ARGON_THREADS must be at least 1")
+	}
+
+	// A layout must contain time elements and parse back what it formats.
+	ref := time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)
+	formatted := ref.Format(cfg.UI.DateFormat)
+	if formatted == cfg.UI.DateFormat {
+		return fmt.Errorf("UI_DATE_FORMAT %q contains no time elements", cfg.UI.DateFormat)
+	}
+	if _, err := time.Parse(cfg.UI.DateFormat, formatted); err != nil {
+		return fmt.Errorf("UI_DATE_FORMAT %q: %w", cfg.UI.DateFormat, err)
+	}
+
+	return nil
+}
+
+// parseFlags overrides env values with command line flags.
+func parseFlags(cfg *Config, args []string) error {
+	fs := flag.NewFlagSet("gophkeeper", flag.ContinueOnError)
+	fs.Int64Var(&cfg.Binary.MaxSizeMB, "binary-max-size", cfg.Binary.MaxSizeMB, "max binary secret size in MB")
+	fs.StringVar(&cfg.Crypto.KeyFile, "crypto-key-file", cfg.Crypto.KeyFile, "file containing the crypto key")
+	fs.BoolVar(&cfg.Crypto.Interactive, "interactive-crypto-key", cfg.Crypto.Interactive, "prompt for the crypto key on the terminal")
+	fs.StringVar(&cfg.UI.DateFormat, "date-format", cfg.UI.DateFormat, "Go time layout for timestamps")
+	fs.StringVar(&cfg.UI.Theme, "theme", cfg.UI.Theme, "TUI color theme: default, default-light, monokai, solarized, solarized-light, nord or one from UI_THEMES_FILE (default: by terminal background)")
+
+	return fs.Parse(args)
+}
+
+// loadCryptoKey prompts for the key in interactive mode, otherwise reads it from
+// KeyFile when set. Exactly one of Key and KeyFile must be set.
+func loadCryptoKey(c *Crypto) error {
+	if c.Interactive {
+		key, err := PromptCryptoKey(false)
+		if err != nil {
+			return err
+		}
+		c.Key, c.KeyFile = key, ""
+		return nil
+	}
+
+	switch {
+	case c.Key != "" && c.KeyFile != "":
+		return errors.New("CRYPTO_KEY and CRYPTO_KEY_FILE are mutually exclusive")
+	case c.KeyFile == "":
+		if c.Key == "" {
+			return errors.New("CRYPTO_KEY or CRYPTO_KEY_FILE is required")
+		}
+		return nil
+	}
+
+	info, err := os.Stat(c.KeyFile)
+	if err != nil {
+		return fmt.Errorf("crypto key file: %w", err)
+	}
+	if info.Mode().Perm()&0o004 != 0 {
+		slog.Warn("crypto key file is world-readable", "path", c.KeyFile, "mode", info.Mode().Perm())
+	}
+
+	data, err := os.ReadFile(c.KeyFile)
+	if err != nil {
+		return fmt.Errorf("crypto key file: %w", err)
+	}
+	c.Key = strings.TrimSpace(string(data))
+	if c.Key == "" {
+		return fmt.Errorf("crypto key file %s is empty", c.KeyFile)
+	}
+
+	return nil
+}
+
+// PromptCryptoKey reads the crypto key from the terminal without echo.
+// With confirm set, as when a new cache is created, the key is asked twice.
+func PromptCryptoKey(confirm bool) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", errors.New("interactive crypto key requires a terminal on stdin")
+	}
+
+	key, err := readPassword(fd, "Crypto key: ")
+	if err != nil {
+		return "", err
+	}
+	if key == "" {
+		return "", errors.New("crypto key is empty")
+	}
+	if confirm {
+		again, err := readPassword(fd, "Repeat crypto key: ")
+		if err != nil {
+			return "", err
+		}
+		if again != key {
+			return "", errors.New("crypto keys do not match")
+		}
+	}
+
+	return key, nil
+}
+
+func readPassword(fd int, prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	secret, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("read crypto key: %w", err)
+	}
+	defer crypto.ZeroBytes(secret)
+
+	return string(secret), nil
+}
+
+// ArgonParams returns the configured Argon2id cost parameters.
+func (c Crypto) ArgonParams() crypto.ArgonParams {
+	return crypto.ArgonParams{Time: c.ArgonTime, Memory: c.ArgonMemory, Threads: c.ArgonThreads}
+}
+
+// VersionString returns the version with a short commit hash, e.g. "v1.2.3 (abc1234)".
+func (a App) VersionString() string {
+	if a.Commit == "" {
+		return a.Version
+	}
+	commit := a.Commit
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	return fmt.Sprintf("%s (%s)", a.Version, commit)
+}
@@ -0,0 +1,197 @@
+package configs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func setRequiredEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("APP_NAME", "gophkeeper")
+	t.Setenv("APP_VERSION", "v1.0.0")
+	t.Setenv("HTTP_HOST", "localhost")
+	t.Setenv("HTTP_PORT", "8080")
+	t.Setenv("LOG_LEVEL", "info")
+	t.Setenv("CRYPTO_KEY", "secret")
+	t.Setenv("CRYPTO_KEY_FILE", "")
+}
+
+func TestNewConfigFromEnv(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("BINARY_MAX_SIZE_MB", "25")
+	t.Setenv("ARGON_TIME", "3")
+
+	cfg, err := NewConfig(nil)
+	if err != nil {
+		t.Fatalf("NewConfig: %v", err)
+	}
+	if cfg.HTTP.Host != "localhost" || cfg.HTTP.Port != "8080" {
+		t.Errorf("HTTP = %+v, want localhost:8080", cfg.HTTP)
+	}
+	if cfg.Crypto.Key != "secret" {
+		t.Errorf("Crypto.Key = %q, want %q", cfg.Crypto.Key, "secret")
+	}
+	if cfg.Binary.MaxSizeMB != 25 {
+		t.Errorf("Binary.MaxSizeMB = %d, want 25", cfg.Binary.MaxSizeMB)
+	}
+	if cfg.Crypto.ArgonTime != 3 || cfg.Crypto.ArgonMemory != 65536 {
+		t.Errorf("Argon = %d/%d, want 3/65536", cfg.Crypto.ArgonTime, cfg.Crypto.ArgonMemory)
+	}
+}
+
+func TestNewConfigFlagOverridesEnv(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("BINARY_MAX_SIZE_MB", "5")
+	t.Setenv("UI_THEME", "nord")
+
+	cfg, err := NewConfig([]string{"--binary-max-size=20", "--theme", "monokai", "--date-format", "02.01.2006"})
+	if err != nil {
+		t.Fatalf("NewConfig: %v", err)
+	}
+	if cfg.Binary.MaxSizeMB != 20 {
+		t.Errorf("Binary.MaxSizeMB = %d, want 20", cfg.Binary.MaxSizeMB)
+	}
+	if cfg.UI.Theme != "monokai" {
+		t.Errorf("UI.Theme = %q, want monokai", cfg.UI.Theme)
+	}
+	if cfg.UI.DateFormat != "02.01.2006" {
+		t.Errorf("UI.DateFormat = %q, want 02.01.2006", cfg.UI.DateFormat)
+	}
+}
+
+func TestNewConfigNoColor(t *testing.T) {
+	tests := []struct {
+		name        string
+		noColor     string
+		gophkeeper  string
+		wantNoColor bool
+	}{
+		{"unset", "", "", false},
+		{"NO_COLOR", "1", "", true},
+		{"NO_COLOR any value", "no", "", true},
+		{"GOPHKEEPER_NO_COLOR", "", "true", true},
+		{"GOPHKEEPER_NO_COLOR false", "", "false", false},
+		{"NO_COLOR wins", "1", "false", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setRequiredEnv(t)
+			t.Setenv("NO_COLOR", tt.noColor)
+			t.Setenv("GOPHKEEPER_NO_COLOR", tt.gophkeeper)
+
+			cfg, err := NewConfig(nil)
+			if err != nil {
+				t.Fatalf("NewConfig: %v", err)
+			}
+			if cfg.UI.NoColor != tt.wantNoColor {
+				t.Errorf("UI.NoColor = %v, want %v", cfg.UI.NoColor, tt.wantNoColor)
+			}
+		})
+	}
+}
+
+func TestNewConfigUnknownFlag(t *testing.T) {
+	setRequiredEnv(t)
+
+	if _, err := NewConfig([]string{"--no-such-flag"}); err == nil {
+		t.Fatal("NewConfig: expected error for unknown flag")
+	}
+}
+
+func TestNewConfigCryptoKey(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key")
+	if err := os.WriteFile(keyFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		key     string
+		keyFile string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{name: "key", key: "from-env", want: "from-env"},
+		{name: "key file", keyFile: keyFile, want: "from-file"},
+		{name: "key file flag", args: []string{"--crypto-key-file", keyFile}, want: "from-file"},
+		{name: "both", key: "from-env", keyFile: keyFile, wantErr: "mutually exclusive"},
+		{name: "key and file flag", key: "from-env", args: []string{"--crypto-key-file", keyFile}, wantErr: "mutually exclusive"},
+		{name: "neither", wantErr: "is required"},
+		{name: "missing file", keyFile: filepath.Join(dir, "missing"), wantErr: "crypto key file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setRequiredEnv(t)
+			t.Setenv("CRYPTO_KEY", tt.key)
+			t.Setenv("CRYPTO_KEY_FILE", tt.keyFile)
+
+			cfg, err := NewConfig(tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("NewConfig: error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewConfig: %v", err)
+			}
+			if cfg.Crypto.Key != tt.want {
+				t.Errorf("Crypto.Key = %q, want %q", cfg.Crypto.Key, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() *Config {
+		return &Config{
+			HTTP:   HTTP{Host: "localhost", Port: "8080"},
+			Log:    Log{Level: "info"},
+			Crypto: Crypto{Key: "secret", ArgonTime: 1, ArgonMemory: 65536, ArgonThreads: 4},
+			Binary: Binary{MaxSizeMB: 10},
+			UI:     UI{DateFormat: "2006-01-02 15:04"},
+		}
+	}
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr string
+	}{
+		{name: "valid", modify: func(*Config) {}},
+		{name: "port not a number", modify: func(c *Config) { c.HTTP.Port = "http" }, wantErr: "HTTP_PORT"},
+		{name: "port zero", modify: func(c *Config) { c.HTTP.Port = "0" }, wantErr: "HTTP_PORT"},
+		{name: "port too large", modify: func(c *Config) { c.HTTP.Port = "65536" }, wantErr: "HTTP_PORT"},
+		{name: "log level upper case", modify: func(c *Config) { c.Log.Level = "DEBUG" }},
+		{name: "unknown log level", modify: func(c *Config) { c.Log.Level = "verbose" }, wantErr: "LOG_LEVEL"},
+		{name: "zero binary limit", modify: func(c *Config) { c.Binary.MaxSizeMB = 0 }, wantErr: "BINARY_MAX_SIZE_MB"},
+		{name: "negative binary limit", modify: func(c *Config) { c.Binary.MaxSizeMB = -1 }, wantErr: "BINARY_MAX_SIZE_MB"},
+		{name: "argon time", modify: func(c *Config) { c.Crypto.ArgonTime = 0 }, wantErr: "ARGON_TIME"},
+		{name: "argon memory", modify: func(c *Config) { c.Crypto.ArgonMemory = 1024 }, wantErr: "ARGON_MEMORY"},
+		{name: "argon threads", modify: func(c *Config) { c.Crypto.ArgonThreads = 0 }, wantErr: "ARGON_THREADS"},
+		{name: "date format without elements", modify: func(c *Config) { c.UI.DateFormat = "date" }, wantErr: "UI_DATE_FORMAT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := valid()
+			tt.modify(cfg)
+
+			err := Validate(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate: error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -11,11 +11,13 @@ type LoginPassword struct {
 	Login    string `json:"login" db:"login"`
 	Password string `json:"password" db:"password"`
 	Label    string `json:"label" db:"label"`
+	URL      string `json:"url" db:"url"`
 }
 
 type TextSecret struct {
-	Title string `json:"title" db:"title"`
-	Body  string `json:"body" db:"body"`
+	Title string   `json:"title" db:"title"`
+	Body  string   `json:"body" db:"body"`
+	Tags  []string `json:"tags" db:"tags"`
 }
 
 type BinarySecret struct {
@@ -74,3 +76,36 @@ type GetBinarySecret struct {
 type GetCardSecret struct {
 	Cardholder string `json:"cardholder" db:"cardholder"`
 }
+
+// PATCH /v1/api/user/secret/update-*.
+type UpdateLoginPassword struct {
+	OldLogin    string `json:"old_login" db:"old_login"`
+	NewLogin    string `json:"new_login" db:"new_login"`
+	NewPassword string `json:"new_password" db:"new_password"`
+	NewLabel    string `json:"new_label" db:"new_label"`
+	NewURL      string `json:"new_url" db:"new_url"`
+}
+
+type UpdateTextSecret struct {
+	OldTitle string   `json:"old_title" db:"old_title"`
+	NewTitle string   `json:"new_title" db:"new_title"`
+	NewBody  string   `json:"new_body" db:"new_body"`
+	NewTags  []string `json:"new_tags" db:"new_tags"`
+}
+
+type UpdateBinarySecret struct {
+	OldFilename string `json:"old_filename" db:"old_filename"`
+	NewFilename string `json:"new_filename" db:"new_filename"`
+	NewMimeType string `json:"new_mime_type" db:"new_mime_type"`
+	NewData     string `json:"new_data" db:"new_data"`
+}
+
+type UpdateCardSecret struct {
+	OldCardholder string `json:"old_cardholder" db:"old_cardholder"`
+	NewCardholder string `json:"new_cardholder" db:"new_cardholder"`
+	NewPan        string `json:"new_pan" db:"new_pan"`
+	NewExpMonth   string `json:"new_exp_month" db:"new_exp_month"`
+	NewExpYear    string `json:"new_exp_year" db:"new_exp_year"`
+	NewBrand      string `json:"new_brand" db:"new_brand"`
+	NewLast4      string `json:"new_last4" db:"new_last4"`
+}
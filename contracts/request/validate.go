@@ -0,0 +1,146 @@
+package request
+
+import "github.com/Eanhain/gophkeeper-client/internal/validation"
+
+// Validatable is implemented by every request type.
+// Validate returns a *validation.ValidationError for the first invalid field.
+type Validatable interface {
+	Validate() error
+}
+
+var (
+	_ Validatable = UserInput{}
+	_ Validatable = LoginPassword{}
+	_ Validatable = TextSecret{}
+	_ Validatable = BinarySecret{}
+	_ Validatable = CardSecret{}
+	_ Validatable = DeleteLoginPassword{}
+	_ Validatable = DeleteTextSecret{}
+	_ Validatable = DeleteBinarySecret{}
+	_ Validatable = DeleteCardSecret{}
+	_ Validatable = GetLoginPassword{}
+	_ Validatable = GetTextSecret{}
+	_ Validatable = GetBinarySecret{}
+	_ Validatable = GetCardSecret{}
+	_ Validatable = UpdateLoginPassword{}
+	_ Validatable = UpdateTextSecret{}
+	_ Validatable = UpdateBinarySecret{}
+	_ Validatable = UpdateCardSecret{}
+)
+
+func (r UserInput) Validate() error {
+	if err := validation.Required("login", r.Login); err != nil {
+		return err
+	}
+	return validation.Required("password", r.Password)
+}
+
+func (r LoginPassword) Validate() error {
+	return validation.Login("login", r.Login)
+}
+
+func (r TextSecret) Validate() error {
+	return validation.Required("title", r.Title)
+}
+
+func (r BinarySecret) Validate() error {
+	if err := validation.Required("filename", r.Filename); err != nil {
+		return err
+	}
+	if err := validation.MimeType("mime_type", r.MimeType); err != nil {
+		return err
+	}
+	return validation.Base64("data", r.Data)
+}
+
+func (r CardSecret) Validate() error {
+	if err := validation.Required("cardholder", r.Cardholder); err != nil {
+		return err
+	}
+	if err := validation.PAN("pan", r.Pan); err != nil {
+		return err
+	}
+	if err := validation.ExpMonth("exp_month", r.ExpMonth); err != nil {
+		return err
+	}
+	return validation.ExpYear("exp_year", r.ExpYear)
+}
+
+func (r DeleteLoginPassword) Validate() error {
+	return validation.Required("login", r.Login)
+}
+
+func (r DeleteTextSecret) Validate() error {
+	return validation.Required("title", r.Title)
+}
+
+func (r DeleteBinarySecret) Validate() error {
+	return validation.Required("filename", r.Filename)
+}
+
+func (r DeleteCardSecret) Validate() error {
+	return validation.Required("cardholder", r.Cardholder)
+}
+
+func (r GetLoginPassword) Validate() error {
+	return validation.Required("login", r.Login)
+}
+
+func (r GetTextSecret) Validate() error {
+	return validation.Required("title", r.Title)
+}
+
+func (r GetBinarySecret) Validate() error {
+	return validation.Required("filename", r.Filename)
+}
+
+func (r GetCardSecret) Validate() error {
+	return validation.Required("cardholder", r.Cardholder)
+}
+
+// Update requests identify the secret by its old identifier; new fields are
+// optional and only checked when set.
+
+func (r UpdateLoginPassword) Validate() error {
+	if err := validation.Required("old_login", r.OldLogin); err != nil {
+		return err
+	}
+	if r.NewLogin != "" {
+		return validation.Login("new_login", r.NewLogin)
+	}
+	return nil
+}
+
+func (r UpdateTextSecret) Validate() error {
+	return validation.Required("old_title", r.OldTitle)
+}
+
+func (r UpdateBinarySecret) Validate() error {
+	if err := validation.Required("old_filename", r.OldFilename); err != nil {
+		return err
+	}
+	if err := validation.MimeType("new_mime_type", r.NewMimeType); err != nil {
+		return err
+	}
+	return validation.Base64("new_data", r.NewData)
+}
+
+func (r UpdateCardSecret) Validate() error {
+	if err := validation.Required("old_cardholder", r.OldCardholder); err != nil {
+		return err
+	}
+	if r.NewPan != "" {
+		if err := validation.PAN("new_pan", r.NewPan); err != nil {
+			return err
+		}
+	}
+	if r.NewExpMonth != "" {
+		if err := validation.ExpMonth("new_exp_month", r.NewExpMonth); err != nil {
+			return err
+		}
+	}
+	if r.NewExpYear != "" {
+		return validation.ExpYear("new_exp_year", r.NewExpYear)
+	}
+	return nil
+}
@@ -1,22 +1,53 @@
 package response
 
-import "github.com/Eanhain/gophkeeper-client/internal/entity"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Eanhain/gophkeeper-client/internal/entity"
+)
 
 type LoginPassword struct {
 	Login    string `json:"login" db:"login"`
 	Password string `json:"password" db:"password"`
 	Label    string `json:"label" db:"label"`
+	URL      string `json:"url" db:"url"`
 }
 
 type TextSecret struct {
-	Title string `json:"title" db:"title"`
-	Body  string `json:"body" db:"body"`
+	Title     string    `json:"title" db:"title"`
+	Body      string    `json:"body" db:"body"`
+	Tags      []string  `json:"tags" db:"tags"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
 }
 
 type BinarySecret struct {
 	Filename string `json:"filename" db:"filename"`
 	MimeType string `json:"mime_type" db:"mime_type"`
 	Data     string `json:"data" db:"data"`
+	Size     int64  `json:"size" db:"size"`
+}
+
+// UnmarshalJSON decodes b and recomputes Size from Data, ignoring any size
+// field in the input.
+func (s *BinarySecret) UnmarshalJSON(b []byte) error {
+	type plain BinarySecret
+	var v plain
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	v.Size = decodedSize(v.Data)
+	*s = BinarySecret(v)
+	return nil
+}
+
+// decodedSize returns the number of bytes encoded by the base64 string data.
+func decodedSize(data string) int64 {
+	padding := len(data) - len(strings.TrimRight(data, "="))
+	return int64(base64.StdEncoding.DecodedLen(len(data)) - padding)
 }
 
 type CardSecret struct {
@@ -28,6 +59,25 @@ type CardSecret struct {
 	Last4      string `json:"last4" db:"last4"`
 }
 
+// Redacted returns a copy with every password character replaced by "•".
+func (r LoginPassword) Redacted() LoginPassword {
+	r.Password = strings.Repeat("•", utf8.RuneCountInString(r.Password))
+	return r
+}
+
+// MaskedPAN returns the card number with all but the last four digits hidden.
+func (c CardSecret) MaskedPAN() string {
+	return "•••• •••• •••• " + c.Last4
+}
+
+// FormattedExpiry returns the expiry date as "MM/YYYY".
+func (c CardSecret) FormattedExpiry() string {
+	if c.ExpMonth == "" && c.ExpYear == "" {
+		return ""
+	}
+	return c.ExpMonth + "/" + c.ExpYear
+}
+
 type AllSecrets struct {
 	LoginPassword []LoginPassword `json:"login_password" db:"login_password"`
 	TextSecret    []TextSecret    `json:"text_secret" db:"text_secret"`
@@ -40,13 +90,16 @@ func FromLoginPassword(value entity.LoginPassword) LoginPassword {
 		Login:    value.Login,
 		Password: value.Password,
 		Label:    value.Label,
+		URL:      value.URL,
 	}
 }
 
 func FromTextSecret(value entity.TextSecret) TextSecret {
 	return TextSecret{
-		Title: value.Title,
-		Body:  value.Body,
+		Title:     value.Title,
+		Body:      value.Body,
+		Tags:      value.Tags,
+		CreatedAt: value.CreatedAt,
 	}
 }
 
@@ -55,6 +108,7 @@ func FromBinarySecret(value entity.BinarySecret) BinarySecret {
 		Filename: value.Filename,
 		MimeType: value.MimeType,
 		Data:     value.Data,
+		Size:     decodedSize(value.Data),
 	}
 }
 
@@ -109,3 +163,98 @@ func FromAllSecrets(values entity.AllSecrets) AllSecrets {
 		CardSecret:    FromCardSecrets(values.CardSecret),
 	}
 }
+
+// Total returns the number of secrets of all kinds. A nil a has none.
+func (a *AllSecrets) Total() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.LoginPassword) + len(a.TextSecret) + len(a.BinarySecret) + len(a.CardSecret)
+}
+
+// IsEmpty reports whether a holds no secrets. A nil a is empty.
+func (a *AllSecrets) IsEmpty() bool {
+	return a.Total() == 0
+}
+
+// BinarySize returns the total decoded size of the binary secrets.
+func (a *AllSecrets) BinarySize() int64 {
+	var size int64
+	if a == nil {
+		return size
+	}
+	for _, value := range a.BinarySecret {
+		size += value.Size
+	}
+	return size
+}
+
+// Filter returns a new AllSecrets with the items for which predicate returns true.
+// predicate receives LoginPassword, TextSecret, BinarySecret or CardSecret values.
+// A nil a is treated as empty.
+func (a *AllSecrets) Filter(predicate func(secret interface{}) bool) *AllSecrets {
+	if a == nil {
+		a = &AllSecrets{}
+	}
+	result := &AllSecrets{
+		LoginPassword: make([]LoginPassword, 0, len(a.LoginPassword)),
+		TextSecret:    make([]TextSecret, 0, len(a.TextSecret)),
+		BinarySecret:  make([]BinarySecret, 0, len(a.BinarySecret)),
+		CardSecret:    make([]CardSecret, 0, len(a.CardSecret)),
+	}
+	for _, value := range a.LoginPassword {
+		if predicate(value) {
+			result.LoginPassword = append(result.LoginPassword, value)
+		}
+	}
+	for _, value := range a.TextSecret {
+		if predicate(value) {
+			result.TextSecret = append(result.TextSecret, value)
+		}
+	}
+	for _, value := range a.BinarySecret {
+		if predicate(value) {
+			result.BinarySecret = append(result.BinarySecret, value)
+		}
+	}
+	for _, value := range a.CardSecret {
+		if predicate(value) {
+			result.CardSecret = append(result.CardSecret, value)
+		}
+	}
+	return result
+}
+
+// Merge returns a new AllSecrets with the union of a and other, deduplicated by
+// Login, Title, Filename and Cardholder. Values from other win on conflict.
+// A nil a or other is treated as empty.
+func (a *AllSecrets) Merge(other *AllSecrets) *AllSecrets {
+	if a == nil {
+		a = &AllSecrets{}
+	}
+	if other == nil {
+		other = &AllSecrets{}
+	}
+	return &AllSecrets{
+		LoginPassword: mergeBy(a.LoginPassword, other.LoginPassword, func(v LoginPassword) string { return v.Login }),
+		TextSecret:    mergeBy(a.TextSecret, other.TextSecret, func(v TextSecret) string { return v.Title }),
+		BinarySecret:  mergeBy(a.BinarySecret, other.BinarySecret, func(v BinarySecret) string { return v.Filename }),
+		CardSecret:    mergeBy(a.CardSecret, other.CardSecret, func(v CardSecret) string { return v.Cardholder }),
+	}
+}
+
+func mergeBy[T any](base, other []T, key func(T) string) []T {
+	result := make([]T, 0, len(base)+len(other))
+	index := make(map[string]int, len(base)+len(other))
+	for _, values := range [][]T{base, other} {
+		for _, value := range values {
+			if i, ok := index[key(value)]; ok {
+				result[i] = value
+				continue
+			}
+			index[key(value)] = len(result)
+			result = append(result, value)
+		}
+	}
+	return result
+}
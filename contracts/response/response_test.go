@@ -0,0 +1,60 @@
+package response
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAllSecretsNil(t *testing.T) {
+	var a *AllSecrets
+	if got := a.Total(); got != 0 {
+		t.Errorf("Total() = %d, want 0", got)
+	}
+	if !a.IsEmpty() {
+		t.Error("IsEmpty() = false, want true")
+	}
+	if got := a.BinarySize(); got != 0 {
+		t.Errorf("BinarySize() = %d, want 0", got)
+	}
+	if got := a.Filter(func(interface{}) bool { return true }); !got.IsEmpty() {
+		t.Errorf("Filter() = %+v, want empty", got)
+	}
+}
+
+func TestAllSecretsTotal(t *testing.T) {
+	a := &AllSecrets{
+		LoginPassword: []LoginPassword{{Login: "a"}, {Login: "b"}},
+		TextSecret:    []TextSecret{{Title: "t"}},
+		CardSecret:    []CardSecret{{Cardholder: "c"}},
+	}
+	if got := a.Total(); got != 4 {
+		t.Errorf("Total() = %d, want 4", got)
+	}
+	if a.IsEmpty() {
+		t.Error("IsEmpty() = true, want false")
+	}
+}
+
+func TestBinarySecretUnmarshalSize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int64
+	}{
+		{"size ignored", `{"filename":"a","data":"aGVsbG8=","size":999}`, 5},
+		{"size missing", `{"filename":"a","data":"aGk="}`, 2},
+		{"no padding", `{"filename":"a","data":"aGV5"}`, 3},
+		{"empty", `{"filename":"a"}`, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s BinarySecret
+			if err := json.Unmarshal([]byte(tt.input), &s); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if s.Size != tt.want || s.Filename != "a" {
+				t.Errorf("got %+v, want Size %d", s, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Package base64util streams files to and from base64 without holding
+// both the raw and encoded copies in memory.
+package base64util
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const chunkSize = 64 * 1024
+
+// EncodeFile returns the standard base64 encoding of the file at path.
+func EncodeFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("base64util: open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var sb strings.Builder
+	if info, err := f.Stat(); err == nil {
+		sb.Grow(base64.StdEncoding.EncodedLen(int(info.Size())))
+	}
+
+	enc := base64.NewEncoder(base64.StdEncoding, &sb)
+	if _, err := io.CopyBuffer(enc, f, make([]byte, chunkSize)); err != nil {
+		return "", fmt.Errorf("base64util: encode %s: %w", path, err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("base64util: encode %s: %w", path, err)
+	}
+
+	return sb.String(), nil
+}
+
+// DecodeToFile decodes standard base64 encoded data into destPath with
+// owner-only permissions. Data is decoded into a temporary file in the same
+// directory that replaces destPath only on success, so malformed input leaves
+// an existing file untouched.
+func DecodeToFile(encoded, destPath string) (err error) {
+	f, err := os.CreateTemp(filepath.Dir(destPath), "."+filepath.Base(destPath)+".*")
+	if err != nil {
+		return fmt.Errorf("base64util: create %s: %w", destPath, err)
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	dec := base64.NewDecoder(base64.StdEncoding, strings.NewReader(encoded))
+	if _, err := io.CopyBuffer(f, dec, make([]byte, chunkSize)); err != nil {
+		return fmt.Errorf("base64util: decode to %s: %w", destPath, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("base64util: close %s: %w", destPath, err)
+	}
+	if err := os.Rename(f.Name(), destPath); err != nil {
+		return fmt.Errorf("base64util: rename to %s: %w", destPath, err)
+	}
+
+	return nil
+}
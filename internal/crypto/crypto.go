@@ -0,0 +1,91 @@
+// Package crypto implements symmetric encryption of client side data with AES-GCM.
+// Ciphertext layout is nonce || sealed data.
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+)
+
+var (
+	// ErrShortCiphertext is returned when ciphertext is shorter than the nonce.
+	ErrShortCiphertext = errors.New("crypto: ciphertext too short")
+	// ErrAuthentication is returned when ciphertext or additional data was tampered with.
+	ErrAuthentication = errors.New("crypto: message authentication failed")
+)
+
+// Encrypt encrypts plaintext with key (16, 24 or 32 bytes).
+func Encrypt(key, plaintext []byte) ([]byte, error) {
+	return EncryptWithAAD(key, plaintext, nil)
+}
+
+// Decrypt decrypts ciphertext produced by Encrypt.
+func Decrypt(key, ciphertext []byte) ([]byte, error) {
+	return DecryptWithAAD(key, ciphertext, nil)
+}
+
+// EncryptWithAAD encrypts plaintext and binds it to aad. The same aad must be
+// passed to DecryptWithAAD, otherwise decryption fails with ErrAuthentication.
+func EncryptWithAAD(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("crypto: read nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// DecryptWithAAD decrypts ciphertext produced by EncryptWithAAD with the same aad.
+func DecryptWithAAD(key, ciphertext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, ErrShortCiphertext
+	}
+
+	nonce, sealed := ciphertext[:nonceSize], ciphertext[nonceSize:]
+	plaintext, err := gcm.Open(nil, nonce, sealed, aad)
+	if err != nil {
+		return nil, ErrAuthentication
+	}
+
+	return plaintext, nil
+}
+
+// ZeroBytes overwrites b with zeros. Call it (usually via defer) on slices holding
+// keys, passwords or plaintext once they are no longer needed.
+func ZeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+	// Keep b reachable past the loop so the writes are not eliminated as dead stores.
+	runtime.KeepAlive(b)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: new cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: new gcm: %w", err)
+	}
+
+	return gcm, nil
+}
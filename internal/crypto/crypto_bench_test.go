@@ -0,0 +1,16 @@
+package crypto
+
+import "testing"
+
+func BenchmarkEncrypt1MB(b *testing.B) {
+	key := make([]byte, KeySize)
+	plaintext := make([]byte, 1<<20)
+	b.SetBytes(int64(len(plaintext)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		if _, err := Encrypt(key, plaintext); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestZeroBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{"nil", nil},
+		{"empty", []byte{}},
+		{"key", bytes.Repeat([]byte{0xAB}, KeySize)},
+		{"password", []byte("correct horse battery staple")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ZeroBytes(tt.b)
+			for i, c := range tt.b {
+				if c != 0 {
+					t.Fatalf("byte %d = %#x after ZeroBytes, want 0", i, c)
+				}
+			}
+		})
+	}
+}
+
+func TestZeroBytesSubslice(t *testing.T) {
+	b := []byte("keep-zero-keep")
+	ZeroBytes(b[5:9])
+	if want := []byte("keep-\x00\x00\x00\x00-keep"); !bytes.Equal(b, want) {
+		t.Errorf("got %q, want %q", b, want)
+	}
+}
+
+// AES-256-GCM vectors from the GCM specification (McGrew & Viega), test cases
+// 13 and 14. Ciphertext layout is nonce || sealed data || tag.
+func TestEncryptWithNonceVectors(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		nonce     string
+		plaintext string
+		want      string
+	}{
+		{
+			name:  "empty plaintext",
+			key:   "0000000000000000000000000000000000000000000000000000000000000000",
+			nonce: "000000000000000000000000",
+			want:  "000000000000000000000000" + "530f8afbc74536b9a963b4f1c4cb738b",
+		},
+		{
+			name:      "one block",
+			key:       "0000000000000000000000000000000000000000000000000000000000000000",
+			nonce:     "000000000000000000000000",
+			plaintext: "00000000000000000000000000000000",
+			want:      "000000000000000000000000" + "cea7403d4d606b6e074ec5d3baf39d18" + "d0d1c8a799996bf0265b98b5d48ab919",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, nonce, plaintext := mustHex(t, tt.key), mustHex(t, tt.nonce), mustHex(t, tt.plaintext)
+
+			got, err := EncryptWithNonce(key, plaintext, nonce)
+			if err != nil {
+				t.Fatalf("EncryptWithNonce: %v", err)
+			}
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("EncryptWithNonce = %x, want %s", got, tt.want)
+			}
+
+			decrypted, err := Decrypt(key, got)
+			if err != nil {
+				t.Fatalf("Decrypt: %v", err)
+			}
+			if !bytes.Equal(decrypted, plaintext) {
+				t.Errorf("Decrypt = %x, want %x", decrypted, plaintext)
+			}
+		})
+	}
+}
+
+func TestEncryptWithNonceSize(t *testing.T) {
+	if _, err := EncryptWithNonce(make([]byte, KeySize), nil, []byte{1}); !errors.Is(err, errNonceSize) {
+		t.Errorf("EncryptWithNonce: error %v, want %v", err, errNonceSize)
+	}
+}
+
+func TestDecryptTampered(t *testing.T) {
+	key := make([]byte, KeySize)
+	c, err := Encrypt(key, []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c[len(c)-1] ^= 1
+	if _, err := Decrypt(key, c); !errors.Is(err, ErrAuthentication) {
+		t.Errorf("Decrypt: error %v, want %v", err, ErrAuthentication)
+	}
+	if _, err := Decrypt(key, c[:5]); !errors.Is(err, ErrShortCiphertext) {
+		t.Errorf("Decrypt: error %v, want %v", err, ErrShortCiphertext)
+	}
+}
+
+func TestEncryptDecryptStream(t *testing.T) {
+	const chunk = StreamChunkSize
+	sealed := chunk + 16 // GCM tag
+	// chunkAt returns the bounds of sealed chunk i in a stream.
+	chunkAt := func(i int) (int, int) {
+		from := streamPrefixSize + i*sealed
+		return from, from + sealed
+	}
+
+	tests := []struct {
+		name    string
+		size    int
+		tamper  func(c []byte) []byte
+		wantErr error
+	}{
+		{name: "empty", size: 0},
+		{name: "one byte", size: 1},
+		{name: "chunk-1", size: chunk - 1},
+		{name: "chunk", size: chunk},
+		{name: "chunk+1", size: chunk + 1},
+		{name: "3*chunk+17", size: 3*chunk + 17},
+		{
+			name: "truncated at chunk boundary",
+			size: 3*chunk + 17,
+			tamper: func(c []byte) []byte {
+				_, end := chunkAt(2)
+				return c[:end]
+			},
+			wantErr: ErrAuthentication,
+		},
+		{
+			name:    "truncated inside chunk",
+			size:    chunk + 1,
+			tamper:  func(c []byte) []byte { return c[:len(c)-5] },
+			wantErr: ErrAuthentication,
+		},
+		{
+			name: "chunks swapped",
+			size: 3*chunk + 17,
+			tamper: func(c []byte) []byte {
+				a0, a1 := chunkAt(0)
+				b0, b1 := chunkAt(1)
+				out := append([]byte{}, c[:a0]...)
+				out = append(out, c[b0:b1]...)
+				out = append(out, c[a0:a1]...)
+				return append(out, c[b1:]...)
+			},
+			wantErr: ErrAuthentication,
+		},
+		{
+			name:    "bit flipped",
+			size:    chunk + 1,
+			tamper:  func(c []byte) []byte { c[streamPrefixSize] ^= 1; return c },
+			wantErr: ErrAuthentication,
+		},
+		{
+			name:    "prefix only",
+			size:    1,
+			tamper:  func(c []byte) []byte { return c[:streamPrefixSize] },
+			wantErr: ErrAuthentication,
+		},
+		{
+			name:    "short prefix",
+			size:    1,
+			tamper:  func(c []byte) []byte { return c[:3] },
+			wantErr: ErrShortCiphertext,
+		},
+	}
+	key := make([]byte, KeySize)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plain := make([]byte, tt.size)
+			for i := range plain {
+				plain[i] = byte(i * 7)
+			}
+			var c bytes.Buffer
+			if err := EncryptStream(key, bytes.NewReader(plain), &c); err != nil {
+				t.Fatalf("EncryptStream: %v", err)
+			}
+			data := c.Bytes()
+			if tt.tamper != nil {
+				data = tt.tamper(data)
+			}
+
+			var out bytes.Buffer
+			err := DecryptStream(key, bytes.NewReader(data), &out)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("DecryptStream: error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecryptStream: %v", err)
+			}
+			if !bytes.Equal(out.Bytes(), plain) {
+				t.Errorf("round trip of %d bytes returned %d different bytes", len(plain), out.Len())
+			}
+		})
+	}
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
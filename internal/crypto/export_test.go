@@ -0,0 +1,20 @@
+package crypto
+
+import "errors"
+
+var errNonceSize = errors.New("crypto: invalid nonce size")
+
+// EncryptWithNonce encrypts plaintext like Encrypt but with the supplied nonce
+// instead of a random one, for deterministic test vectors. Testing only:
+// reusing a nonce with the same key breaks AES-GCM.
+func EncryptWithNonce(key, plaintext, nonce []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, errNonceSize
+	}
+
+	return gcm.Seal(append([]byte(nil), nonce...), nonce, plaintext, nil), nil
+}
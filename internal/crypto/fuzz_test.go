@@ -0,0 +1,45 @@
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+func FuzzDecrypt(f *testing.F) {
+	key := make([]byte, KeySize)
+	c, err := Encrypt(key, []byte("x"))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(c)
+	f.Add([]byte{})
+	f.Add(c[:11])
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, err := Decrypt(key, data)
+		if err != nil && !errors.Is(err, ErrShortCiphertext) && !errors.Is(err, ErrAuthentication) {
+			t.Errorf("Decrypt: unexpected error %v", err)
+		}
+	})
+}
+
+func FuzzDecryptStream(f *testing.F) {
+	key := make([]byte, KeySize)
+	for _, size := range []int{0, 1, StreamChunkSize, StreamChunkSize + 1} {
+		var c bytes.Buffer
+		if err := EncryptStream(key, bytes.NewReader(make([]byte, size)), &c); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(c.Bytes())
+	}
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		err := DecryptStream(key, bytes.NewReader(data), io.Discard)
+		if err != nil && !errors.Is(err, ErrShortCiphertext) && !errors.Is(err, ErrAuthentication) {
+			t.Errorf("DecryptStream: unexpected error %v", err)
+		}
+	})
+}
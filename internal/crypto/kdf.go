@@ -0,0 +1,97 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Key derivation algorithm identifiers as stored alongside encrypted data.
+const (
+	AlgoSHA256   = "sha256"
+	AlgoArgon2ID = "argon2id"
+)
+
+// KeySize is the length of derived keys (AES-256).
+const KeySize = 32
+
+// Default Argon2id parameters.
+const (
+	DefaultArgonTime    uint32 = 1
+	DefaultArgonMemory  uint32 = 64 * 1024
+	DefaultArgonThreads uint8  = 4
+)
+
+// ArgonParams are Argon2id cost parameters. Memory is in KiB. Data encrypted
+// with a key must keep the params it was derived with next to it.
+type ArgonParams struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
+// ErrArgonParams is returned for Argon2id parameters that argon2 cannot use.
+var ErrArgonParams = errors.New("crypto: invalid argon2id parameters")
+
+// Validate checks that Time and Threads are at least 1 and Memory is at least
+// 8 KiB per thread, the minimum of the Argon2 specification.
+func (p ArgonParams) Validate() error {
+	switch {
+	case p.Time < 1:
+		return fmt.Errorf("%w: time must be at least 1", ErrArgonParams)
+	case p.Threads < 1:
+		return fmt.Errorf("%w: threads must be at least 1", ErrArgonParams)
+	case p.Memory < 8*uint32(p.Threads):
+		return fmt.Errorf("%w: memory %d KiB is below 8 KiB per thread", ErrArgonParams, p.Memory)
+	}
+	return nil
+}
+
+// DefaultArgonParams returns the default Argon2id parameters.
+func DefaultArgonParams() ArgonParams {
+	return ArgonParams{Time: DefaultArgonTime, Memory: DefaultArgonMemory, Threads: DefaultArgonThreads}
+}
+
+// DeriveKey derives an AES-256 key from passphrase and salt with Argon2id
+// using the default parameters.
+func DeriveKey(passphrase string, salt []byte) []byte {
+	return DeriveKeyWithParams(passphrase, salt, DefaultArgonParams())
+}
+
+// DeriveKeyWithParams derives an AES-256 key from passphrase and salt with Argon2id.
+func DeriveKeyWithParams(passphrase string, salt []byte, p ArgonParams) []byte {
+	secret := []byte(passphrase)
+	defer ZeroBytes(secret)
+
+	return argon2.IDKey(secret, salt, p.Time, p.Memory, p.Threads, KeySize)
+}
+
+// DeriveKeySHA256 derives a key with a single SHA-256 pass.
+// Kept only to open data encrypted before the switch to Argon2id.
+func DeriveKeySHA256(passphrase string) []byte {
+	secret := []byte(passphrase)
+	defer ZeroBytes(secret)
+
+	sum := sha256.Sum256(secret)
+	return sum[:]
+}
+
+// DeriveKeyFor derives a key with the algorithm named by algo and reports
+// whether the data should be re-encrypted with Argon2id. p applies to
+// AlgoArgon2ID only and must pass Validate; argon2 panics on some invalid
+// values. An algo other than AlgoSHA256 or AlgoArgon2ID is an error.
+func DeriveKeyFor(algo, passphrase string, salt []byte, p ArgonParams) (key []byte, migrate bool, err error) {
+	switch algo {
+	case AlgoSHA256:
+		return DeriveKeySHA256(passphrase), true, nil
+	case AlgoArgon2ID:
+		if err := p.Validate(); err != nil {
+			return nil, false, err
+		}
+		return DeriveKeyWithParams(passphrase, salt, p), false, nil
+	default:
+		return nil, false, fmt.Errorf("crypto: unknown key derivation algorithm %q", algo)
+	}
+}
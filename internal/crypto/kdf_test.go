@@ -0,0 +1,50 @@
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestDeriveKeyFor(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	valid := ArgonParams{Time: 1, Memory: 64, Threads: 2}
+	tests := []struct {
+		name        string
+		algo        string
+		params      ArgonParams
+		wantMigrate bool
+		wantErr     error
+	}{
+		{name: "argon2id", algo: AlgoArgon2ID, params: valid},
+		{name: "argon2id minimum memory", algo: AlgoArgon2ID, params: ArgonParams{Time: 1, Memory: 16, Threads: 2}},
+		{name: "sha256 ignores params", algo: AlgoSHA256, wantMigrate: true},
+		{name: "zero time", algo: AlgoArgon2ID, params: ArgonParams{Memory: 64, Threads: 2}, wantErr: ErrArgonParams},
+		{name: "zero threads", algo: AlgoArgon2ID, params: ArgonParams{Time: 1, Memory: 64}, wantErr: ErrArgonParams},
+		{name: "memory below 8 KiB per thread", algo: AlgoArgon2ID, params: ArgonParams{Time: 1, Memory: 15, Threads: 2}, wantErr: ErrArgonParams},
+		{name: "zero params", algo: AlgoArgon2ID, wantErr: ErrArgonParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, migrate, err := DeriveKeyFor(tt.algo, "passphrase", salt, tt.params)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeriveKeyFor: error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(key) != KeySize || migrate != tt.wantMigrate {
+				t.Errorf("got %d-byte key, migrate %v; want %d, %v", len(key), migrate, KeySize, tt.wantMigrate)
+			}
+		})
+	}
+
+	if _, _, err := DeriveKeyFor("scrypt", "passphrase", salt, valid); err == nil {
+		t.Error("DeriveKeyFor: expected error for unknown algorithm")
+	}
+	a, _, _ := DeriveKeyFor(AlgoArgon2ID, "passphrase", salt, valid)
+	b := DeriveKeyWithParams("passphrase", salt, valid)
+	if !bytes.Equal(a, b) {
+		t.Error("DeriveKeyFor and DeriveKeyWithParams derive different keys")
+	}
+}
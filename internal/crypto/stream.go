@@ -0,0 +1,142 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Streams are split into StreamChunkSize plaintext chunks, each sealed with
+// AES-GCM under nonce = prefix(7) || counter(4) || last(1). The counter
+// prevents reordering and the last flag detects truncation.
+// Stream layout is prefix || sealed chunk...
+const (
+	StreamChunkSize   = 64 * 1024
+	streamPrefixSize  = 7
+	streamCounterSize = 4
+)
+
+// ErrStreamTooLong is returned when a stream exceeds the chunk counter range.
+var ErrStreamTooLong = errors.New("crypto: stream too long")
+
+// EncryptStream encrypts r into w chunk by chunk, so memory use does not grow
+// with the input size.
+func EncryptStream(key []byte, r io.Reader, w io.Writer) error {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	prefix := make([]byte, streamPrefixSize)
+	if _, err := io.ReadFull(rand.Reader, prefix); err != nil {
+		return fmt.Errorf("crypto: read nonce: %w", err)
+	}
+	if _, err := w.Write(prefix); err != nil {
+		return fmt.Errorf("crypto: write stream: %w", err)
+	}
+
+	buf := make([]byte, StreamChunkSize)
+	next := make([]byte, StreamChunkSize)
+	sealed := make([]byte, 0, StreamChunkSize+gcm.Overhead())
+	defer ZeroBytes(buf)
+	defer ZeroBytes(next)
+
+	n, err := readChunk(r, buf)
+	if err != nil {
+		return err
+	}
+	for counter := uint64(0); ; counter++ {
+		if counter > math.MaxUint32 {
+			return ErrStreamTooLong
+		}
+		// Read ahead to know whether the current chunk is the last one.
+		var m int
+		if n == len(buf) {
+			if m, err = readChunk(r, next); err != nil {
+				return err
+			}
+		}
+		last := m == 0
+
+		sealed = gcm.Seal(sealed[:0], streamNonce(prefix, uint32(counter), last), buf[:n], nil)
+		if _, err := w.Write(sealed); err != nil {
+			return fmt.Errorf("crypto: write stream: %w", err)
+		}
+		if last {
+			return nil
+		}
+		buf, next, n = next, buf, m
+	}
+}
+
+// DecryptStream decrypts a stream produced by EncryptStream from r into w.
+// Plaintext is written chunk by chunk as each chunk is authenticated; on
+// ErrAuthentication the output written so far must be discarded.
+func DecryptStream(key []byte, r io.Reader, w io.Writer) error {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	prefix := make([]byte, streamPrefixSize)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return ErrShortCiphertext
+	}
+
+	size := StreamChunkSize + gcm.Overhead()
+	buf := make([]byte, size)
+	next := make([]byte, size)
+	plain := make([]byte, 0, StreamChunkSize)
+	defer ZeroBytes(plain[:cap(plain)])
+
+	n, err := readChunk(r, buf)
+	if err != nil {
+		return err
+	}
+	for counter := uint64(0); ; counter++ {
+		if counter > math.MaxUint32 {
+			return ErrStreamTooLong
+		}
+		var m int
+		if n == len(buf) {
+			if m, err = readChunk(r, next); err != nil {
+				return err
+			}
+		}
+		last := m == 0
+
+		plain, err = gcm.Open(plain[:0], streamNonce(prefix, uint32(counter), last), buf[:n], nil)
+		if err != nil {
+			return ErrAuthentication
+		}
+		if _, err := w.Write(plain); err != nil {
+			return fmt.Errorf("crypto: write stream: %w", err)
+		}
+		if last {
+			return nil
+		}
+		buf, next, n = next, buf, m
+	}
+}
+
+// readChunk fills buf as far as r allows and returns the number of bytes read.
+func readChunk(r io.Reader, buf []byte) (int, error) {
+	n, err := io.ReadFull(r, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return n, fmt.Errorf("crypto: read stream: %w", err)
+	}
+	return n, nil
+}
+
+func streamNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, streamPrefixSize+streamCounterSize+1)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[streamPrefixSize:], counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
@@ -1,16 +1,21 @@
 package entity
 
+import "time"
+
 type LoginPassword struct {
 	UserID   int    `json:"user_id" db:"user_id"`
 	Login    string `json:"login" db:"login"`
 	Password string `json:"password" db:"password"`
 	Label    string `json:"label" db:"label"`
+	URL      string `json:"url" db:"url"` // new server column, requires a server-side migration
 }
 
 type TextSecret struct {
-	UserID int    `json:"user_id" db:"user_id"`
-	Title  string `json:"title" db:"title"`
-	Body   string `json:"body" db:"body"`
+	UserID    int       `json:"user_id" db:"user_id"`
+	Title     string    `json:"title" db:"title"`
+	Body      string    `json:"body" db:"body"`
+	Tags      []string  `json:"tags" db:"tags"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
 }
 
 type BinarySecret struct {
@@ -36,3 +41,43 @@ type AllSecrets struct {
 	BinarySecret  []BinarySecret  `json:"binary_secret" db:"binary_secret"`
 	CardSecret    []CardSecret    `json:"card_secret" db:"card_secret"`
 }
+
+// Secret type tags, matching the AllSecrets json field names.
+const (
+	SecretTypeLoginPassword = "login_password"
+	SecretTypeTextSecret    = "text_secret"
+	SecretTypeBinarySecret  = "binary_secret"
+	SecretTypeCardSecret    = "card_secret"
+)
+
+// ForEach calls fn for every secret with its type tag and typed value.
+func (a *AllSecrets) ForEach(fn func(secretType string, secret interface{})) {
+	a.ForEachWithStop(func(secretType string, secret interface{}) bool {
+		fn(secretType, secret)
+		return true
+	})
+}
+
+// ForEachWithStop is like ForEach but stops as soon as fn returns false.
+func (a *AllSecrets) ForEachWithStop(fn func(secretType string, secret interface{}) bool) {
+	for _, value := range a.LoginPassword {
+		if !fn(SecretTypeLoginPassword, value) {
+			return
+		}
+	}
+	for _, value := range a.TextSecret {
+		if !fn(SecretTypeTextSecret, value) {
+			return
+		}
+	}
+	for _, value := range a.BinarySecret {
+		if !fn(SecretTypeBinarySecret, value) {
+			return
+		}
+	}
+	for _, value := range a.CardSecret {
+		if !fn(SecretTypeCardSecret, value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+func init() {
+	register(BitwardenExporter{})
+}
+
+// Bitwarden item types.
+const (
+	bitwardenLogin      = 1
+	bitwardenSecureNote = 2
+	bitwardenCard       = 3
+)
+
+type bitwardenExport struct {
+	Encrypted bool            `json:"encrypted"`
+	Folders   []struct{}      `json:"folders"`
+	Items     []bitwardenItem `json:"items"`
+}
+
+type bitwardenItem struct {
+	Type       int                  `json:"type"`
+	Name       string               `json:"name"`
+	Notes      *string              `json:"notes"`
+	Favorite   bool                 `json:"favorite"`
+	Login      *bitwardenLoginData  `json:"login,omitempty"`
+	SecureNote *bitwardenSecureData `json:"secureNote,omitempty"`
+	Card       *bitwardenCardData   `json:"card,omitempty"`
+}
+
+type bitwardenLoginData struct {
+	URIs     []bitwardenURI `json:"uris"`
+	Username string         `json:"username"`
+	Password string         `json:"password"`
+}
+
+type bitwardenURI struct {
+	URI string `json:"uri"`
+}
+
+type bitwardenSecureData struct {
+	Type int `json:"type"`
+}
+
+type bitwardenCardData struct {
+	CardholderName string `json:"cardholderName"`
+	Brand          string `json:"brand"`
+	Number         string `json:"number"`
+	ExpMonth       string `json:"expMonth"`
+	ExpYear        string `json:"expYear"`
+}
+
+// BitwardenExporter writes an unencrypted Bitwarden JSON export. Login/password,
+// text and card secrets map to login, secure note and card items; binary
+// secrets are skipped because Bitwarden exports do not carry attachments.
+type BitwardenExporter struct{}
+
+func (BitwardenExporter) FormatName() string { return "bitwarden" }
+
+func (BitwardenExporter) Export(w io.Writer, s *response.AllSecrets) error {
+	out := bitwardenExport{
+		Folders: []struct{}{},
+		Items:   make([]bitwardenItem, 0, len(s.LoginPassword)+len(s.TextSecret)+len(s.CardSecret)),
+	}
+	for _, v := range s.LoginPassword {
+		name := v.Label
+		if name == "" {
+			name = v.Login
+		}
+		login := &bitwardenLoginData{URIs: []bitwardenURI{}, Username: v.Login, Password: v.Password}
+		if v.URL != "" {
+			login.URIs = append(login.URIs, bitwardenURI{URI: v.URL})
+		}
+		out.Items = append(out.Items, bitwardenItem{Type: bitwardenLogin, Name: name, Login: login})
+	}
+	for _, v := range s.TextSecret {
+		body := v.Body
+		out.Items = append(out.Items, bitwardenItem{
+			Type: bitwardenSecureNote, Name: v.Title, Notes: &body, SecureNote: &bitwardenSecureData{},
+		})
+	}
+	for _, v := range s.CardSecret {
+		out.Items = append(out.Items, bitwardenItem{Type: bitwardenCard, Name: v.Cardholder, Card: &bitwardenCardData{
+			CardholderName: v.Cardholder,
+			Brand:          v.Brand,
+			Number:         v.Pan,
+			// Bitwarden stores months without a leading zero.
+			ExpMonth: strings.TrimLeft(v.ExpMonth, "0"),
+			ExpYear:  v.ExpYear,
+		}})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return fmt.Errorf("export bitwarden: %w", err)
+	}
+	return nil
+}
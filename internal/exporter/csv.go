@@ -0,0 +1,78 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+	"github.com/Eanhain/gophkeeper-client/internal/entity"
+)
+
+// Secret type tags used in the CSV type column.
+const (
+	TypeLoginPassword = entity.SecretTypeLoginPassword
+	TypeTextSecret    = entity.SecretTypeTextSecret
+	TypeBinarySecret  = entity.SecretTypeBinarySecret
+	TypeCardSecret    = entity.SecretTypeCardSecret
+)
+
+// CSVHeader is the column layout written by CSVExporter. Columns that do not
+// apply to a row's type are left empty; tags are comma separated.
+var CSVHeader = []string{
+	"type",
+	"login", "password", "label", "url",
+	"title", "body", "tags",
+	"filename", "mime_type", "data",
+	"cardholder", "pan", "exp_month", "exp_year", "brand", "last4",
+}
+
+func init() {
+	register(CSVExporter{})
+}
+
+// CSVExporter writes secrets as a single CSV table with a type column.
+type CSVExporter struct{}
+
+func (CSVExporter) FormatName() string { return "csv" }
+
+func (CSVExporter) Export(w io.Writer, s *response.AllSecrets) error {
+	cw := csv.NewWriter(w)
+	rows := make([][]string, 0, s.Total()+1)
+	rows = append(rows, CSVHeader)
+	for _, v := range s.LoginPassword {
+		rows = append(rows, csvRow(TypeLoginPassword, map[string]string{
+			"login": v.Login, "password": v.Password, "label": v.Label, "url": v.URL,
+		}))
+	}
+	for _, v := range s.TextSecret {
+		rows = append(rows, csvRow(TypeTextSecret, map[string]string{
+			"title": v.Title, "body": v.Body, "tags": strings.Join(v.Tags, ","),
+		}))
+	}
+	for _, v := range s.BinarySecret {
+		rows = append(rows, csvRow(TypeBinarySecret, map[string]string{
+			"filename": v.Filename, "mime_type": v.MimeType, "data": v.Data,
+		}))
+	}
+	for _, v := range s.CardSecret {
+		rows = append(rows, csvRow(TypeCardSecret, map[string]string{
+			"cardholder": v.Cardholder, "pan": v.Pan, "exp_month": v.ExpMonth,
+			"exp_year": v.ExpYear, "brand": v.Brand, "last4": v.Last4,
+		}))
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("export csv: %w", err)
+	}
+	return nil
+}
+
+func csvRow(secretType string, values map[string]string) []string {
+	row := make([]string, len(CSVHeader))
+	row[0] = secretType
+	for i, column := range CSVHeader[1:] {
+		row[i+1] = values[column]
+	}
+	return row
+}
@@ -0,0 +1,140 @@
+package exporter
+
+import (
+	"bufio"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+func init() {
+	register(EnvExporter{})
+}
+
+// EnvExporter writes secrets as POSIX shell export statements, e.g.
+// export GITHUB_LOGIN='me' and export GITHUB_PASSWORD='...'.
+// Variable names are built from the label (or login), title, filename or cardholder.
+// Secrets whose names map to the same variable are an error, since the later
+// export would silently overwrite the earlier one when sourced.
+type EnvExporter struct{}
+
+func (EnvExporter) FormatName() string { return "env" }
+
+func (EnvExporter) Export(w io.Writer, s *response.AllSecrets) error {
+	var vars envVars
+	for _, v := range s.LoginPassword {
+		name := v.Label
+		if name == "" {
+			name = v.Login
+		}
+		vars.add(name, "LOGIN", v.Login)
+		vars.add(name, "PASSWORD", v.Password)
+		if v.URL != "" {
+			vars.add(name, "URL", v.URL)
+		}
+	}
+	for _, v := range s.TextSecret {
+		vars.add(v.Title, "", v.Body)
+	}
+	for _, v := range s.BinarySecret {
+		vars.add(v.Filename, "", v.Data)
+	}
+	for _, v := range s.CardSecret {
+		vars.add(v.Cardholder, "PAN", v.Pan)
+		vars.add(v.Cardholder, "EXP_MONTH", v.ExpMonth)
+		vars.add(v.Cardholder, "EXP_YEAR", v.ExpYear)
+	}
+	if err := vars.check(); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, v := range vars {
+		fmt.Fprintf(bw, "export %s=%s\n", v.key, shellQuote(v.value))
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("export env: %w", err)
+	}
+	return nil
+}
+
+type envVar struct {
+	name, key, value string
+}
+
+// envVars collects variables before writing so that collisions are detected
+// before any output.
+type envVars []envVar
+
+func (vs *envVars) add(name, suffix, value string) {
+	key := envName(name)
+	if suffix != "" {
+		key += "_" + suffix
+	}
+	*vs = append(*vs, envVar{name: name, key: key, value: value})
+}
+
+func (vs envVars) check() error {
+	names := make(map[string]string, len(vs))
+	for _, v := range vs {
+		prev, ok := names[v.key]
+		switch {
+		case ok && prev == v.name:
+			return fmt.Errorf("export env: several secrets named %q map to %s", v.name, v.key)
+		case ok:
+			return fmt.Errorf("export env: %q and %q both map to %s", prev, v.name, v.key)
+		}
+		names[v.key] = v.name
+	}
+	return nil
+}
+
+// envName upper-cases name, transliterates Cyrillic letters and replaces
+// everything else outside [A-Z0-9] with "_". Other non-ASCII letters and digits
+// cannot be spelled in a variable name, so when name has any, a hash of name
+// is appended to keep e.g. "日記" and "写真" apart.
+func envName(name string) string {
+	var sb strings.Builder
+	lossy := false
+	for _, r := range strings.ToUpper(name) {
+		switch {
+		case (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'):
+			sb.WriteRune(r)
+		case cyrillic[r] != "":
+			sb.WriteString(cyrillic[r])
+		case r == 'Ъ' || r == 'Ь':
+			// hard and soft signs have no sound of their own
+		default:
+			lossy = lossy || unicode.IsLetter(r) || unicode.IsDigit(r)
+			sb.WriteByte('_')
+		}
+	}
+	key := sb.String()
+	if lossy {
+		h := fnv.New32a()
+		h.Write([]byte(name))
+		key += fmt.Sprintf("_%08X", h.Sum32())
+	}
+	if key == "" || (key[0] >= '0' && key[0] <= '9') {
+		key = "_" + key
+	}
+	return key
+}
+
+// cyrillic maps upper-case Cyrillic letters to their Latin transliteration.
+var cyrillic = map[rune]string{
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Д': "D", 'Е': "E", 'Ё': "YO",
+	'Ж': "ZH", 'З': "Z", 'И': "I", 'Й': "Y", 'К': "K", 'Л': "L", 'М': "M",
+	'Н': "N", 'О': "O", 'П': "P", 'Р': "R", 'С': "S", 'Т': "T", 'У': "U",
+	'Ф': "F", 'Х': "KH", 'Ц': "TS", 'Ч': "CH", 'Ш': "SH", 'Щ': "SHCH",
+	'Ы': "Y", 'Э': "E", 'Ю': "YU", 'Я': "YA",
+	'Є': "YE", 'І': "I", 'Ї': "YI", 'Ґ': "G", 'Ў': "U",
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,74 @@
+package exporter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+func TestEnvName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"github", "GITHUB"},
+		{"my-api key", "MY_API_KEY"},
+		{"2fa", "_2FA"},
+		{"", "_"},
+		{"Пароль", "PAROL"},
+		{"Заметка", "ZAMETKA"},
+		{"Щука ёж", "SHCHUKA_YOZH"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := envName(tt.name); got != tt.want {
+				t.Errorf("envName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvNameLossy(t *testing.T) {
+	a, b := envName("日記"), envName("写真")
+	if a == b {
+		t.Errorf("envName gives %q for both names", a)
+	}
+	if !strings.HasPrefix(a, "___") {
+		t.Errorf("envName(%q) = %q, want a \"___\" prefix", "日記", a)
+	}
+}
+
+func TestEnvExportCyrillic(t *testing.T) {
+	s := &response.AllSecrets{
+		TextSecret: []response.TextSecret{
+			{Title: "Пароль", Body: "one"},
+			{Title: "Заметка", Body: "two"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := (EnvExporter{}).Export(&buf, s); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	want := "export PAROL='one'\nexport ZAMETKA='two'\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Export() = %q, want %q", got, want)
+	}
+}
+
+func TestEnvExportCollision(t *testing.T) {
+	s := &response.AllSecrets{
+		TextSecret: []response.TextSecret{
+			{Title: "api key", Body: "one"},
+			{Title: "api-key", Body: "two"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := (EnvExporter{}).Export(&buf, s); err == nil {
+		t.Fatal("Export: expected collision error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Export wrote %q before failing", buf.String())
+	}
+}
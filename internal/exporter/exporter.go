@@ -0,0 +1,64 @@
+// Package exporter writes decrypted secrets to an io.Writer in several formats.
+// Exporters register themselves in init and are looked up by format name.
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+// Exporter writes all secrets to w.
+type Exporter interface {
+	Export(w io.Writer, s *response.AllSecrets) error
+	FormatName() string
+}
+
+var (
+	registry = map[string]Exporter{}
+
+	// extensions maps file extensions to format names for Detect.
+	extensions = map[string]string{
+		".json": "json",
+		".csv":  "csv",
+		".env":  "env",
+		".sh":   "env",
+	}
+)
+
+func register(exp Exporter) {
+	registry[exp.FormatName()] = exp
+}
+
+// New returns the exporter registered for format.
+func New(format string) (Exporter, error) {
+	exp, ok := registry[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("unknown export format %q (supported: %s)", format, strings.Join(Formats(), ", "))
+	}
+	return exp, nil
+}
+
+// Detect guesses the exporter from the filename extension.
+func Detect(filename string) (Exporter, error) {
+	ext := strings.ToLower(filepath.Ext(filename))
+	format, ok := extensions[ext]
+	if !ok {
+		return nil, fmt.Errorf("cannot detect export format from %q", filename)
+	}
+	return New(format)
+}
+
+// Formats returns the registered format names in sorted order.
+func Formats() []string {
+	formats := make([]string, 0, len(registry))
+	for name := range registry {
+		formats = append(formats, name)
+	}
+	sort.Strings(formats)
+	return formats
+}
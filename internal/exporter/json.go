@@ -0,0 +1,27 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+func init() {
+	register(JSONExporter{})
+}
+
+// JSONExporter writes secrets as indented JSON in the response.AllSecrets layout.
+type JSONExporter struct{}
+
+func (JSONExporter) FormatName() string { return "json" }
+
+func (JSONExporter) Export(w io.Writer, s *response.AllSecrets) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s); err != nil {
+		return fmt.Errorf("export json: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+	"github.com/Eanhain/gophkeeper-client/internal/entity"
+)
+
+func init() {
+	register(CSVImporter{})
+}
+
+// CSVImporter reads the single-table layout written by exporter.CSVExporter.
+// Columns are matched by header name, so their order does not matter.
+type CSVImporter struct{}
+
+func (CSVImporter) FormatName() string { return "csv" }
+
+func (CSVImporter) Import(r io.Reader) (*response.AllSecrets, error) {
+	rows, err := readCSV(r)
+	if err != nil {
+		return nil, fmt.Errorf("import csv: %w", err)
+	}
+
+	s := &response.AllSecrets{}
+	for i, row := range rows {
+		switch row["type"] {
+		case entity.SecretTypeLoginPassword:
+			s.LoginPassword = append(s.LoginPassword, response.LoginPassword{
+				Login: row["login"], Password: row["password"], Label: row["label"], URL: row["url"],
+			})
+		case entity.SecretTypeTextSecret:
+			s.TextSecret = append(s.TextSecret, response.TextSecret{
+				Title: row["title"], Body: row["body"], Tags: splitTags(row["tags"]),
+			})
+		case entity.SecretTypeBinarySecret:
+			s.BinarySecret = append(s.BinarySecret, response.BinarySecret{
+				Filename: row["filename"], MimeType: row["mime_type"], Data: row["data"],
+			})
+		case entity.SecretTypeCardSecret:
+			s.CardSecret = append(s.CardSecret, response.CardSecret{
+				Cardholder: row["cardholder"], Pan: row["pan"], ExpMonth: row["exp_month"],
+				ExpYear: row["exp_year"], Brand: row["brand"], Last4: row["last4"],
+			})
+		default:
+			return nil, fmt.Errorf("import csv: row %d: unknown type %q", i+2, row["type"])
+		}
+	}
+	return s, nil
+}
+
+// readCSV returns data rows as maps keyed by the lower-cased header names.
+func readCSV(r io.Reader) ([]map[string]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+
+	var rows []map[string]string
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		row := make(map[string]string, len(header))
+		for i, column := range header {
+			if i < len(record) {
+				row[column] = record[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+func splitTags(s string) []string {
+	if s == "" {
+		return nil
+	}
+	tags := strings.Split(s, ",")
+	for i := range tags {
+		tags[i] = strings.TrimSpace(tags[i])
+	}
+	return tags
+}
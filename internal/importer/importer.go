@@ -0,0 +1,43 @@
+// Package importer reads secrets from other formats and password managers.
+// Importers register themselves in init and are looked up by format name.
+package importer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+// Importer parses secrets from r.
+type Importer interface {
+	Import(r io.Reader) (*response.AllSecrets, error)
+	FormatName() string
+}
+
+var registry = map[string]Importer{}
+
+func register(imp Importer) {
+	registry[imp.FormatName()] = imp
+}
+
+// NewImporter returns the importer registered for format.
+func NewImporter(format string) (Importer, error) {
+	imp, ok := registry[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("unknown import format %q (supported: %s)", format, strings.Join(Formats(), ", "))
+	}
+	return imp, nil
+}
+
+// Formats returns the registered format names in sorted order.
+func Formats() []string {
+	formats := make([]string, 0, len(registry))
+	for name := range registry {
+		formats = append(formats, name)
+	}
+	sort.Strings(formats)
+	return formats
+}
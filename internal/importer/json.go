@@ -0,0 +1,26 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+func init() {
+	register(JSONImporter{})
+}
+
+// JSONImporter reads the response.AllSecrets layout written by exporter.JSONExporter.
+type JSONImporter struct{}
+
+func (JSONImporter) FormatName() string { return "json" }
+
+func (JSONImporter) Import(r io.Reader) (*response.AllSecrets, error) {
+	var s response.AllSecrets
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("import json: %w", err)
+	}
+	return &s, nil
+}
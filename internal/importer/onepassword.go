@@ -0,0 +1,44 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+func init() {
+	register(OnePasswordImporter{})
+}
+
+// OnePasswordImporter reads a 1Password CSV export
+// (Title, Url or Website, Username, Password, ...). Rows with neither
+// username nor password are skipped.
+type OnePasswordImporter struct{}
+
+func (OnePasswordImporter) FormatName() string { return "1password" }
+
+func (OnePasswordImporter) Import(r io.Reader) (*response.AllSecrets, error) {
+	rows, err := readCSV(r)
+	if err != nil {
+		return nil, fmt.Errorf("import 1password: %w", err)
+	}
+
+	s := &response.AllSecrets{}
+	for _, row := range rows {
+		if row["username"] == "" && row["password"] == "" {
+			continue
+		}
+		url := row["url"]
+		if url == "" {
+			url = row["website"]
+		}
+		s.LoginPassword = append(s.LoginPassword, response.LoginPassword{
+			Login:    row["username"],
+			Password: row["password"],
+			Label:    row["title"],
+			URL:      url,
+		})
+	}
+	return s, nil
+}
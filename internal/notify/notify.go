@@ -0,0 +1,90 @@
+// Package notify sends user notifications about sync events.
+package notify
+
+import (
+	"fmt"
+	"log/slog"
+	"runtime"
+
+	"github.com/gen2brain/beeep"
+)
+
+// Notifier delivers a notification with a title and body.
+type Notifier interface {
+	Notify(title, body string) error
+}
+
+// NotifierConfig selects the Notifier returned by New.
+type NotifierConfig struct {
+	// Enabled turns notifications on; when false New returns NoOpNotifier.
+	Enabled bool
+	// Desktop prefers desktop notifications on supported systems.
+	Desktop bool
+	// AppName is shown as the notification source.
+	AppName string
+	// Logger is used by LogNotifier; slog.Default when nil.
+	Logger *slog.Logger
+}
+
+// New returns a DesktopNotifier when desktop notifications are requested and
+// supported by the OS, a LogNotifier when only enabled, and NoOpNotifier otherwise.
+func New(cfg NotifierConfig) Notifier {
+	if !cfg.Enabled {
+		return NoOpNotifier{}
+	}
+	if cfg.Desktop && desktopSupported() {
+		return NewDesktopNotifier(cfg.AppName)
+	}
+	return NewLogNotifier(cfg.Logger)
+}
+
+func desktopSupported() bool {
+	switch runtime.GOOS {
+	case "linux", "freebsd", "netbsd", "openbsd", "darwin", "windows":
+		return true
+	}
+	return false
+}
+
+// DesktopNotifier shows native desktop notifications via beeep.
+type DesktopNotifier struct{}
+
+// NewDesktopNotifier returns a DesktopNotifier that reports appName as the source.
+func NewDesktopNotifier(appName string) DesktopNotifier {
+	if appName != "" {
+		beeep.AppName = appName
+	}
+	return DesktopNotifier{}
+}
+
+func (DesktopNotifier) Notify(title, body string) error {
+	if err := beeep.Notify(title, body, ""); err != nil {
+		return fmt.Errorf("desktop notify: %w", err)
+	}
+	return nil
+}
+
+// LogNotifier writes notifications to a slog.Logger at INFO level.
+type LogNotifier struct {
+	logger *slog.Logger
+}
+
+// NewLogNotifier returns a LogNotifier writing to logger, or slog.Default when nil.
+func NewLogNotifier(logger *slog.Logger) LogNotifier {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return LogNotifier{logger: logger}
+}
+
+func (n LogNotifier) Notify(title, body string) error {
+	n.logger.Info("notification", "title", title, "body", body)
+	return nil
+}
+
+// NoOpNotifier discards notifications.
+type NoOpNotifier struct{}
+
+func (NoOpNotifier) Notify(string, string) error {
+	return nil
+}
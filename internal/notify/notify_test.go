@@ -0,0 +1,50 @@
+package notify
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	for _, cfg := range []NotifierConfig{{}, {Desktop: true}} {
+		if _, ok := New(cfg).(NoOpNotifier); !ok {
+			t.Errorf("New(%+v) = %T, want NoOpNotifier", cfg, New(cfg))
+		}
+	}
+
+	var buf bytes.Buffer
+	cfg := NotifierConfig{Enabled: true, Logger: slog.New(slog.NewTextHandler(&buf, nil))}
+	n, ok := New(cfg).(LogNotifier)
+	if !ok {
+		t.Fatalf("New(%+v) = %T, want LogNotifier", cfg, New(cfg))
+	}
+	if err := n.Notify("title", "body"); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if buf.Len() == 0 {
+		t.Error("LogNotifier does not use cfg.Logger")
+	}
+}
+
+func TestLogNotifier(t *testing.T) {
+	var buf bytes.Buffer
+	n := NewLogNotifier(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	if err := n.Notify("Sync finished", "3 secrets updated"); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"level=INFO", `title="Sync finished"`, `body="3 secrets updated"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output %q does not contain %q", out, want)
+		}
+	}
+}
+
+func TestNoOpNotifier(t *testing.T) {
+	if err := (NoOpNotifier{}).Notify("title", "body"); err != nil {
+		t.Errorf("Notify: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+// Package pwgen generates passwords for new secrets.
+//
+// The embedded words.txt is the EFF large wordlist
+// (https://www.eff.org/dice, CC BY 3.0) without hyphenated entries.
+package pwgen
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// Word count bounds for GenerateMemorable.
+const (
+	MinWords = 3
+	MaxWords = 6
+)
+
+var (
+	//go:embed words.txt
+	wordsFile string
+	words     = strings.Fields(wordsFile)
+
+	separators = []string{"-", "."}
+	symbols    = []string{"!", "@", "#", "$", "%", "&", "*", "?"}
+)
+
+// GenerateMemorable returns wordCount random words joined by a random separator,
+// optionally followed by a random digit and symbol, e.g. "maple-orbit-tundra7!".
+func GenerateMemorable(wordCount int) (string, error) {
+	if wordCount < MinWords || wordCount > MaxWords {
+		return "", fmt.Errorf("pwgen: word count must be between %d and %d, got %d", MinWords, MaxWords, wordCount)
+	}
+
+	sep, err := pick(separators)
+	if err != nil {
+		return "", err
+	}
+
+	parts := make([]string, 0, wordCount)
+	for range wordCount {
+		word, err := pick(words)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, word)
+	}
+	password := strings.Join(parts, sep)
+
+	withSuffix, err := randInt(2)
+	if err != nil {
+		return "", err
+	}
+	if withSuffix == 1 {
+		digit, err := randInt(10)
+		if err != nil {
+			return "", err
+		}
+		symbol, err := pick(symbols)
+		if err != nil {
+			return "", err
+		}
+		password += fmt.Sprintf("%d%s", digit, symbol)
+	}
+
+	return password, nil
+}
+
+// MemorableEntropy returns the entropy in bits of a password from GenerateMemorable:
+// wordCount*log2(len(words)) + log2(len(separators)). The optional suffix is not
+// counted, so the value is a lower bound.
+func MemorableEntropy(wordCount int) float64 {
+	return float64(wordCount)*math.Log2(float64(len(words))) + math.Log2(float64(len(separators)))
+}
+
+func pick(values []string) (string, error) {
+	i, err := randInt(len(values))
+	if err != nil {
+		return "", err
+	}
+	return values[i], nil
+}
+
+func randInt(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("pwgen: read random: %w", err)
+	}
+	return int(v.Int64()), nil
+}
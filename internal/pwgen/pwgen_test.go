@@ -0,0 +1,50 @@
+package pwgen
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestGenerateEntropy(t *testing.T) {
+	if len(words) != 7772 {
+		t.Fatalf("word list has %d words, want 7772", len(words))
+	}
+	perWord := math.Log2(7772)
+	tests := []struct {
+		wordCount int
+		want      float64
+	}{
+		{3, 3*perWord + 1},
+		{4, 4*perWord + 1},
+		{5, 5*perWord + 1},
+		{6, 6*perWord + 1},
+	}
+	for _, tt := range tests {
+		if got := MemorableEntropy(tt.wordCount); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("MemorableEntropy(%d) = %f, want %f", tt.wordCount, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateMemorable(t *testing.T) {
+	for wordCount := MinWords; wordCount <= MaxWords; wordCount++ {
+		password, err := GenerateMemorable(wordCount)
+		if err != nil {
+			t.Fatalf("GenerateMemorable(%d): %v", wordCount, err)
+		}
+		sep := "-"
+		if !strings.Contains(password, sep) {
+			sep = "."
+		}
+		if got := len(strings.Split(password, sep)); got != wordCount {
+			t.Errorf("GenerateMemorable(%d) = %q has %d words", wordCount, password, got)
+		}
+	}
+
+	for _, wordCount := range []int{MinWords - 1, MaxWords + 1} {
+		if _, err := GenerateMemorable(wordCount); err == nil {
+			t.Errorf("GenerateMemorable(%d): expected error", wordCount)
+		}
+	}
+}
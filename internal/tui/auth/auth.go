@@ -0,0 +1,147 @@
+// Package auth implements the TUI login/registration screen.
+package auth
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Eanhain/gophkeeper-client/internal/tui/theme"
+)
+
+const (
+	fieldLogin = iota
+	fieldPassword
+)
+
+// SubmitMsg is sent when the user submits filled credentials.
+type SubmitMsg struct {
+	Login    string
+	Password string
+	Register bool
+}
+
+// Model is the auth screen state.
+type Model struct {
+	authInputs []textinput.Model
+	authFocus  int
+	register   bool
+	err        string
+}
+
+// New returns the auth screen in login mode with the login field focused.
+func New() Model {
+	login := textinput.New()
+	login.Placeholder = "Login"
+	login.CharLimit = 64
+	login.Focus()
+
+	password := textinput.New()
+	password.Placeholder = "Password"
+	password.CharLimit = 64
+	password.EchoMode = textinput.EchoPassword
+	password.EchoCharacter = '•'
+
+	return Model{authInputs: []textinput.Model{login, password}}
+}
+
+// Init starts the cursor blink.
+func (m Model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update handles focus, the ctrl+r login/register toggle and submit. Enter
+// advances to the next field and submits only from the last one.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "ctrl+r":
+			m.register = !m.register
+			m.err = ""
+			return m, nil
+		case "tab", "down":
+			return m.setFocus(m.authFocus + 1)
+		case "shift+tab", "up":
+			return m.setFocus(m.authFocus - 1)
+		case "enter":
+			if !m.focusedIsLast() {
+				return m.setFocus(m.authFocus + 1)
+			}
+			return m.submit()
+		}
+	}
+
+	var cmd tea.Cmd
+	m.authInputs[m.authFocus], cmd = m.authInputs[m.authFocus].Update(msg)
+	return m, cmd
+}
+
+// View renders the screen.
+func (m Model) View() string {
+	title := "GophKeeper — Login"
+	toggle := "[ctrl+r]: register"
+	if m.register {
+		title = "GophKeeper — Register"
+		toggle = "[ctrl+r]: login"
+	}
+
+	var b strings.Builder
+	b.WriteString(theme.Title.Render(title))
+	b.WriteString("\n\n")
+	for _, in := range m.authInputs {
+		b.WriteString("  ")
+		b.WriteString(in.View())
+		b.WriteString("\n")
+	}
+	if m.err != "" {
+		b.WriteString("\n")
+		b.WriteString(theme.Error.Render("! " + m.err))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(theme.Help.Render("[tab]: next field | [enter]: next/submit | " + toggle + " | [ctrl+c]: quit"))
+	return b.String()
+}
+
+// Register reports whether the screen is in registration mode.
+func (m Model) Register() bool {
+	return m.register
+}
+
+// Focus returns the index of the focused field.
+func (m Model) Focus() int {
+	return m.authFocus
+}
+
+// Err returns the current validation message.
+func (m Model) Err() string {
+	return m.err
+}
+
+func (m Model) submit() (Model, tea.Cmd) {
+	login := strings.TrimSpace(m.authInputs[fieldLogin].Value())
+	password := m.authInputs[fieldPassword].Value()
+	if login == "" || password == "" {
+		m.err = "login and password are required"
+		return m, nil
+	}
+	m.err = ""
+	submit := SubmitMsg{Login: login, Password: password, Register: m.register}
+	return m, func() tea.Msg { return submit }
+}
+
+func (m Model) focusedIsLast() bool {
+	return m.authFocus == len(m.authInputs)-1
+}
+
+// setFocus moves focus to field i, wrapping around in both directions, and
+// returns the command that starts the cursor blinking there.
+func (m Model) setFocus(i int) (Model, tea.Cmd) {
+	n := len(m.authInputs)
+	i = (i%n + n) % n
+	m.authInputs[m.authFocus].Blur()
+	m.authFocus = i
+	cmd := m.authInputs[m.authFocus].Focus()
+	return m, cmd
+}
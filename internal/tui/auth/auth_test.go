@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func key(t tea.KeyType) tea.KeyMsg {
+	return tea.KeyMsg{Type: t}
+}
+
+func typed(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func update(m Model, msgs ...tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, msg := range msgs {
+		m, cmd = m.Update(msg)
+	}
+	return m, cmd
+}
+
+func TestNavigation(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []tea.Msg
+		want int
+	}{
+		{"starts on login", nil, fieldLogin},
+		{"tab", []tea.Msg{key(tea.KeyTab)}, fieldPassword},
+		{"tab wraps", []tea.Msg{key(tea.KeyTab), key(tea.KeyTab)}, fieldLogin},
+		{"down", []tea.Msg{key(tea.KeyDown)}, fieldPassword},
+		{"shift+tab on login wraps to password", []tea.Msg{key(tea.KeyShiftTab)}, fieldPassword},
+		{"up on login wraps to password", []tea.Msg{key(tea.KeyUp)}, fieldPassword},
+		{"shift+tab on password", []tea.Msg{key(tea.KeyTab), key(tea.KeyShiftTab)}, fieldLogin},
+		{"enter on login moves to password", []tea.Msg{key(tea.KeyEnter)}, fieldPassword},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, cmd := update(New(), tt.keys...)
+			if m.Focus() != tt.want {
+				t.Errorf("Focus() = %d, want %d", m.Focus(), tt.want)
+			}
+			// The command starts the cursor blink; it is not run here
+			// because it waits for the blink interval.
+			if len(tt.keys) > 0 && cmd == nil {
+				t.Errorf("navigation dropped the cursor blink command")
+			}
+		})
+	}
+}
+
+func TestEnterOnLoginDoesNotSubmit(t *testing.T) {
+	m, _ := update(New(), typed("bob"), key(tea.KeyEnter))
+	if m.Focus() != fieldPassword {
+		t.Fatalf("Focus() = %d, want %d", m.Focus(), fieldPassword)
+	}
+	if m.Err() != "" {
+		t.Errorf("Err() = %q, want empty", m.Err())
+	}
+}
+
+func TestSubmitEmpty(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []tea.Msg
+	}{
+		{"both empty", []tea.Msg{key(tea.KeyEnter), key(tea.KeyEnter)}},
+		{"password empty", []tea.Msg{typed("bob"), key(tea.KeyEnter), key(tea.KeyEnter)}},
+		{"login blank", []tea.Msg{typed("  "), key(tea.KeyEnter), typed("pw"), key(tea.KeyEnter)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, cmd := update(New(), tt.keys...)
+			if cmd != nil {
+				t.Fatal("submit with empty fields returned a command")
+			}
+			if m.Err() == "" {
+				t.Error("Err() is empty, want a validation message")
+			}
+		})
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []tea.Msg
+		want SubmitMsg
+	}{
+		{
+			name: "login",
+			keys: []tea.Msg{typed(" bob "), key(tea.KeyEnter), typed("pw"), key(tea.KeyEnter)},
+			want: SubmitMsg{Login: "bob", Password: "pw"},
+		},
+		{
+			name: "register",
+			keys: []tea.Msg{key(tea.KeyCtrlR), typed("bob"), key(tea.KeyEnter), typed("pw"), key(tea.KeyEnter)},
+			want: SubmitMsg{Login: "bob", Password: "pw", Register: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, cmd := update(New(), tt.keys...)
+			if cmd == nil {
+				t.Fatalf("submit returned no command, Err() = %q", m.Err())
+			}
+			if got, ok := cmd().(SubmitMsg); !ok || got != tt.want {
+				t.Errorf("submit sent %#v, want %#v", got, tt.want)
+			}
+			if m.Err() != "" {
+				t.Errorf("Err() = %q, want empty", m.Err())
+			}
+		})
+	}
+}
+
+func TestToggleRegister(t *testing.T) {
+	m := New()
+	if m.Register() {
+		t.Fatal("new screen is in register mode")
+	}
+
+	m, _ = update(m, key(tea.KeyEnter), key(tea.KeyEnter))
+	if m.Err() == "" {
+		t.Fatal("expected a validation message before toggling")
+	}
+
+	m, cmd := update(m, key(tea.KeyCtrlR))
+	if !m.Register() {
+		t.Error("ctrl+r did not switch to register mode")
+	}
+	if m.Err() != "" {
+		t.Errorf("ctrl+r kept Err() = %q", m.Err())
+	}
+	if cmd != nil {
+		t.Error("ctrl+r returned a command")
+	}
+
+	m, _ = update(m, key(tea.KeyCtrlR))
+	if m.Register() {
+		t.Error("second ctrl+r did not switch back to login mode")
+	}
+}
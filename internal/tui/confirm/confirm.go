@@ -0,0 +1,72 @@
+// Package confirm implements a yes/no dialog for destructive TUI actions.
+package confirm
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Eanhain/gophkeeper-client/internal/tui/theme"
+)
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			Padding(0, 1)
+	questionStyle = lipgloss.NewStyle().Bold(true)
+)
+
+// Model is a dialog state. It is resolved once result is set.
+type Model struct {
+	question string
+	active   bool
+	result   *bool
+}
+
+// New returns an active, unresolved dialog.
+func New(question string) Model {
+	return Model{question: question, active: true}
+}
+
+// Update resolves the dialog: y/Y confirms, n/N/esc denies. Other keys are ignored.
+func (c Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !c.active || !ok {
+		return c, nil
+	}
+	switch key.String() {
+	case "y", "Y":
+		return c.resolve(true), nil
+	case "n", "N", "esc":
+		return c.resolve(false), nil
+	}
+	return c, nil
+}
+
+// View renders the question box, or nothing when inactive.
+func (c Model) View() string {
+	if !c.active {
+		return ""
+	}
+	return boxStyle.BorderForeground(lipgloss.Color(theme.Current().Error)).Render(questionStyle.Render(c.question) + "\n\n" + theme.Help.Render("[y]: yes | [n/esc]: no"))
+}
+
+// Active reports whether the dialog is waiting for an answer.
+func (c Model) Active() bool {
+	return c.active
+}
+
+// Resolved reports whether the user answered.
+func (c Model) Resolved() bool {
+	return c.result != nil
+}
+
+// Confirmed reports whether the user answered yes.
+func (c Model) Confirmed() bool {
+	return c.result != nil && *c.result
+}
+
+func (c Model) resolve(yes bool) Model {
+	c.active = false
+	c.result = &yes
+	return c
+}
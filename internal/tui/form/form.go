@@ -0,0 +1,326 @@
+// Package form implements a reusable multi-field input form for the TUI.
+package form
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Eanhain/gophkeeper-client/internal/tui/theme"
+)
+
+// maxHistory bounds the number of saved submit attempts.
+const maxHistory = 20
+
+// Field describes one form input.
+type Field struct {
+	Placeholder string
+	CharLimit   int
+	Masked      bool
+	Required    bool
+	// Validate is an optional extra check run on submit.
+	Validate func(value string) error
+}
+
+// SubmitMsg is sent when a valid form is confirmed from the preview.
+type SubmitMsg struct {
+	Values []string
+}
+
+// Model is a form state.
+type Model struct {
+	inputs []textinput.Model
+	fields []Field
+	focus  int
+	title  string
+	errors []string
+	// history holds form values saved on each submit attempt, newest last,
+	// without consecutive duplicates and at most maxHistory entries.
+	history [][]string
+	// maskedFields tracks which Masked fields currently hide their input;
+	// ctrl+p flips the focused one.
+	maskedFields []bool
+	// preview shows the entered values read-only before submit.
+	preview bool
+	// submitted is set once SubmitMsg is sent; further input is ignored so a
+	// repeated enter cannot submit twice.
+	submitted bool
+}
+
+// New returns a form with the first field focused.
+func New(title string, fields []Field) Model {
+	m := Model{
+		inputs:       make([]textinput.Model, len(fields)),
+		fields:       fields,
+		title:        title,
+		maskedFields: make([]bool, len(fields)),
+	}
+	for i, f := range fields {
+		in := textinput.New()
+		in.Placeholder = f.Placeholder
+		if f.CharLimit > 0 {
+			in.CharLimit = f.CharLimit
+		}
+		in.EchoCharacter = '•'
+		if f.Masked {
+			in.EchoMode = textinput.EchoPassword
+			m.maskedFields[i] = true
+		}
+		m.inputs[i] = in
+	}
+	if len(m.inputs) > 0 {
+		m.inputs[0].Focus()
+	}
+	return m
+}
+
+// Init starts the cursor blink.
+func (m Model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update handles focus navigation, preview and submit, forwarding other input
+// to the focused field. Tab or enter on the last field opens the preview;
+// enter there submits and esc returns to editing.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if len(m.inputs) == 0 || m.submitted {
+		return m, nil
+	}
+	if m.preview {
+		return m.updatePreview(msg)
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "tab":
+			if m.focus == len(m.inputs)-1 {
+				return m.openPreview(), nil
+			}
+			return m.setFocus(m.focus + 1)
+		case "down":
+			return m.setFocus(m.focus + 1)
+		case "shift+tab", "up":
+			return m.setFocus(m.focus - 1)
+		case "ctrl+z":
+			return m.undo(), nil
+		case "ctrl+p":
+			return m.toggleMask(), nil
+		case "enter":
+			if m.focus < len(m.inputs)-1 {
+				return m.setFocus(m.focus + 1)
+			}
+			return m.openPreview(), nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.inputs[m.focus], cmd = m.inputs[m.focus].Update(msg)
+	return m, cmd
+}
+
+// View renders the title, inputs, validation errors and key help, or the
+// preview of the entered values.
+func (m Model) View() string {
+	var b strings.Builder
+	b.WriteString(theme.Title.Render(m.title))
+	b.WriteString("\n\n")
+	if m.preview {
+		for i, f := range m.fields {
+			value := m.inputs[i].Value()
+			if f.Masked {
+				value = strings.Repeat("•", utf8.RuneCountInString(value))
+			}
+			b.WriteString("  " + f.Placeholder + ": " + value + "\n")
+		}
+		b.WriteString("\n")
+		help := "[enter]: submit | [esc]: edit"
+		if m.submitted {
+			help = "submitting…"
+		}
+		b.WriteString(theme.Help.Render(help))
+		return b.String()
+	}
+	for _, in := range m.inputs {
+		b.WriteString("  ")
+		b.WriteString(in.View())
+		b.WriteString("\n")
+	}
+	if len(m.errors) > 0 {
+		b.WriteString("\n")
+		for _, e := range m.errors {
+			b.WriteString(theme.Error.Render("! " + e))
+			b.WriteString("\n")
+		}
+	}
+	b.WriteString("\n")
+	help := "[tab/shift+tab]: move | [enter]: next/preview | [esc]: back"
+	if len(m.history) > 0 {
+		help += " | [ctrl+z]: restore"
+	}
+	if len(m.fields) > 0 && m.fields[m.focus].Masked {
+		help += " | [ctrl+p]: show/hide"
+	}
+	b.WriteString(theme.Help.Render(help))
+	return b.String()
+}
+
+// Values returns the current input values in field order.
+func (m Model) Values() []string {
+	values := make([]string, len(m.inputs))
+	for i, in := range m.inputs {
+		values[i] = in.Value()
+	}
+	return values
+}
+
+// SetValues fills the inputs in field order; extra values are ignored.
+func (m Model) SetValues(values []string) Model {
+	for i := range m.inputs {
+		if i < len(values) {
+			m.inputs[i].SetValue(values[i])
+		}
+	}
+	return m
+}
+
+// History returns the values saved on each submit attempt, newest last.
+// Keep it when leaving the form and pass it to SetHistory on a new form of the
+// same kind so that ctrl+z can recover input lost with esc.
+func (m Model) History() [][]string {
+	return m.history
+}
+
+// SetHistory replaces the undo history.
+func (m Model) SetHistory(history [][]string) Model {
+	m.history = history
+	return m
+}
+
+// Previewing reports whether the form shows the preview. The form handles esc
+// itself while previewing, so callers should not treat it as "back" then.
+func (m Model) Previewing() bool {
+	return m.preview
+}
+
+// Submitted reports whether SubmitMsg was sent. The form ignores input until
+// Resume is called or it is replaced.
+func (m Model) Submitted() bool {
+	return m.submitted
+}
+
+// Resume returns a submitted form to editing with its values kept, e.g. after
+// the submit failed.
+func (m Model) Resume() Model {
+	m.submitted = false
+	m.preview = false
+	return m
+}
+
+// Valid reports whether all required fields are filled and pass validation.
+func (m Model) Valid() bool {
+	return len(m.validate()) == 0
+}
+
+func (m Model) validate() []string {
+	var errs []string
+	for i, f := range m.fields {
+		value := m.inputs[i].Value()
+		if f.Required && strings.TrimSpace(value) == "" {
+			errs = append(errs, fmt.Sprintf("%s is required", f.Placeholder))
+			continue
+		}
+		if f.Validate != nil && value != "" {
+			if err := f.Validate(value); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+	return errs
+}
+
+// openPreview saves the values to history and switches to the preview when
+// they are valid; otherwise the errors are shown on the form.
+func (m Model) openPreview() Model {
+	m = m.pushHistory(m.Values())
+	m.errors = m.validate()
+	m.preview = len(m.errors) == 0
+	return m
+}
+
+func (m Model) updatePreview(msg tea.Msg) (Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch key.String() {
+	case "enter":
+		m.submitted = true
+		values := m.Values()
+		return m, func() tea.Msg { return SubmitMsg{Values: values} }
+	case "esc":
+		m.preview = false
+	}
+	return m, nil
+}
+
+// pushHistory saves values unless they equal the latest entry, dropping the
+// oldest entries beyond maxHistory.
+func (m Model) pushHistory(values []string) Model {
+	if n := len(m.history); n > 0 && slices.Equal(m.history[n-1], values) {
+		return m
+	}
+	m.history = append(m.history, values)
+	if len(m.history) > maxHistory {
+		m.history = slices.Clone(m.history[len(m.history)-maxHistory:])
+	}
+	return m
+}
+
+// undo restores the latest saved values that differ from the current ones.
+func (m Model) undo() Model {
+	current := m.Values()
+	for len(m.history) > 0 {
+		last := m.history[len(m.history)-1]
+		m.history = m.history[:len(m.history)-1]
+		if !slices.Equal(last, current) {
+			m.errors = nil
+			return m.SetValues(last)
+		}
+	}
+	return m
+}
+
+// Masked reports whether field i currently hides its input.
+func (m Model) Masked(i int) bool {
+	return i >= 0 && i < len(m.maskedFields) && m.maskedFields[i]
+}
+
+// toggleMask reveals or hides the focused field; fields not declared Masked
+// are always shown.
+func (m Model) toggleMask() Model {
+	if !m.fields[m.focus].Masked {
+		return m
+	}
+	m.maskedFields = slices.Clone(m.maskedFields)
+	m.maskedFields[m.focus] = !m.maskedFields[m.focus]
+	if m.maskedFields[m.focus] {
+		m.inputs[m.focus].EchoMode = textinput.EchoPassword
+	} else {
+		m.inputs[m.focus].EchoMode = textinput.EchoNormal
+	}
+	return m
+}
+
+// setFocus moves focus to field i, wrapping around in both directions, and
+// returns the command that starts the cursor blinking there.
+func (m Model) setFocus(i int) (Model, tea.Cmd) {
+	n := len(m.inputs)
+	i = (i%n + n) % n
+	m.inputs[m.focus].Blur()
+	m.focus = i
+	cmd := m.inputs[m.focus].Focus()
+	return m, cmd
+}
@@ -0,0 +1,36 @@
+package form
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNavigationFocusesField(t *testing.T) {
+	fields := []Field{{Placeholder: "a"}, {Placeholder: "b"}, {Placeholder: "c"}}
+	tests := []struct {
+		name string
+		key  tea.KeyType
+		want int
+	}{
+		{"tab", tea.KeyTab, 1},
+		{"down", tea.KeyDown, 1},
+		{"enter", tea.KeyEnter, 1},
+		{"up wraps", tea.KeyUp, 2},
+		{"shift+tab wraps", tea.KeyShiftTab, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, cmd := New("t", fields).Update(tea.KeyMsg{Type: tt.key})
+			if m.focus != tt.want {
+				t.Errorf("focus = %d, want %d", m.focus, tt.want)
+			}
+			if !m.inputs[tt.want].Focused() || m.inputs[0].Focused() {
+				t.Error("focus state of the inputs was not moved")
+			}
+			if cmd == nil {
+				t.Error("navigation dropped the cursor blink command")
+			}
+		})
+	}
+}
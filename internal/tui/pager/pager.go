@@ -0,0 +1,36 @@
+// Package pager splits grouped TUI lists, such as secrets by type, into pages.
+package pager
+
+// Span is the rows [From, To) of group Group shown on a page.
+type Span struct {
+	Group, From, To int
+}
+
+// Bounds splits groups of counts[i] rows into pages of pageSize rows. Pages are
+// filled in group order, so a page may span several groups; empty groups are
+// skipped. A pageSize below one is treated as one, e.g. on a very short
+// terminal. The number of pages is len of the result.
+func Bounds(counts []int, pageSize int) [][]Span {
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	var pages [][]Span
+	var page []Span
+	free := pageSize
+	for g, n := range counts {
+		for from := 0; from < n; {
+			to := min(n, from+free)
+			page = append(page, Span{Group: g, From: from, To: to})
+			free -= to - from
+			from = to
+			if free == 0 {
+				pages = append(pages, page)
+				page, free = nil, pageSize
+			}
+		}
+	}
+	if len(page) > 0 {
+		pages = append(pages, page)
+	}
+	return pages
+}
@@ -0,0 +1,41 @@
+package pager
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		counts   []int
+		pageSize int
+		want     [][]Span
+	}{
+		{"no rows", []int{0, 0}, 5, nil},
+		{"nil counts", nil, 5, nil},
+		{"fits one page", []int{2, 1}, 5, [][]Span{{{0, 0, 2}, {1, 0, 1}}}},
+		{"exact page", []int{3, 2}, 5, [][]Span{{{0, 0, 3}, {1, 0, 2}}}},
+		{"group split across pages", []int{4, 3}, 5, [][]Span{
+			{{0, 0, 4}, {1, 0, 1}},
+			{{1, 1, 3}},
+		}},
+		{"group longer than a page", []int{7}, 3, [][]Span{
+			{{0, 0, 3}},
+			{{0, 3, 6}},
+			{{0, 6, 7}},
+		}},
+		{"empty groups skipped", []int{0, 2, 0, 2}, 3, [][]Span{
+			{{1, 0, 2}, {3, 0, 1}},
+			{{3, 1, 2}},
+		}},
+		{"page size below one", []int{2}, 0, [][]Span{{{0, 0, 1}}, {{0, 1, 2}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bounds(tt.counts, tt.pageSize); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Bounds(%v, %d) = %v, want %v", tt.counts, tt.pageSize, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Package prompt implements a single-input overlay for the TUI, used to ask
+// for values such as file paths or keys.
+package prompt
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Eanhain/gophkeeper-client/internal/tui/theme"
+)
+
+var boxStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	Padding(0, 1)
+
+// Model is a prompt state. It is active from New until enter or esc.
+type Model struct {
+	title  string
+	input  textinput.Model
+	active bool
+	done   bool
+}
+
+// New returns an active prompt with a focused input.
+func New(title, placeholder string) Model {
+	in := textinput.New()
+	in.Placeholder = placeholder
+	in.Focus()
+	return Model{
+		title:  title,
+		input:  in,
+		active: true,
+	}
+}
+
+// Update handles enter (submit), esc (cancel) and forwards other input.
+func (p Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !p.active {
+		return p, nil
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "enter":
+			p.active = false
+			p.done = true
+			p.input.Blur()
+			return p, nil
+		case "esc":
+			p.active = false
+			p.input.Blur()
+			return p, nil
+		}
+	}
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	return p, cmd
+}
+
+// View renders the prompt box, or nothing when inactive.
+func (p Model) View() string {
+	if !p.active {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(theme.Title.Render(p.title))
+	b.WriteString("\n\n")
+	b.WriteString(p.input.View())
+	b.WriteString("\n\n")
+	b.WriteString(theme.Help.Render("[enter]: ok | [esc]: cancel"))
+	return boxStyle.BorderForeground(lipgloss.Color(theme.Current().Title)).Render(b.String())
+}
+
+// Value returns the entered text, trimmed.
+func (p Model) Value() string {
+	return strings.TrimSpace(p.input.Value())
+}
+
+// Active reports whether the prompt is waiting for input.
+func (p Model) Active() bool {
+	return p.active
+}
+
+// Done reports whether the prompt was submitted with enter.
+// A prompt closed with esc is inactive but not done.
+func (p Model) Done() bool {
+	return p.done
+}
@@ -0,0 +1,106 @@
+// Package searchbar provides search helpers for the TUI secret views:
+// match highlighting and identifier completion.
+package searchbar
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+// Highlight renders every case-insensitive occurrence of query in text with style.
+// Matching is done on runes, so offsets stay correct for any Unicode input.
+func Highlight(text, query string, style lipgloss.Style) string {
+	if query == "" {
+		return text
+	}
+	runes := []rune(text)
+	hay := foldRunes(runes)
+	needle := foldRunes([]rune(query))
+
+	var b strings.Builder
+	last := 0
+	for i := 0; i+len(needle) <= len(hay); {
+		if !hasPrefix(hay[i:], needle) {
+			i++
+			continue
+		}
+		b.WriteString(string(runes[last:i]))
+		b.WriteString(style.Render(string(runes[i : i+len(needle)])))
+		i += len(needle)
+		last = i
+	}
+	b.WriteString(string(runes[last:]))
+	return b.String()
+}
+
+// Contains reports whether text contains query, ignoring case.
+func Contains(text, query string) bool {
+	needle := foldRunes([]rune(query))
+	hay := foldRunes([]rune(text))
+	for i := 0; i+len(needle) <= len(hay); i++ {
+		if hasPrefix(hay[i:], needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// Completions returns the sorted, distinct secret identifiers (Label, Title,
+// Filename, Cardholder) that start with prefix, ignoring case.
+func Completions(secrets *response.AllSecrets, prefix string) []string {
+	if secrets == nil {
+		return nil
+	}
+	folded := string(foldRunes([]rune(prefix)))
+	seen := make(map[string]struct{})
+	var result []string
+	add := func(candidate string) {
+		if candidate == "" || !strings.HasPrefix(string(foldRunes([]rune(candidate))), folded) {
+			return
+		}
+		if _, ok := seen[candidate]; ok {
+			return
+		}
+		seen[candidate] = struct{}{}
+		result = append(result, candidate)
+	}
+	for _, v := range secrets.LoginPassword {
+		add(v.Label)
+	}
+	for _, v := range secrets.TextSecret {
+		add(v.Title)
+	}
+	for _, v := range secrets.BinarySecret {
+		add(v.Filename)
+	}
+	for _, v := range secrets.CardSecret {
+		add(v.Cardholder)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func foldRunes(runes []rune) []rune {
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		folded[i] = unicode.ToLower(r)
+	}
+	return folded
+}
+
+func hasPrefix(s, prefix []rune) bool {
+	if len(prefix) > len(s) {
+		return false
+	}
+	for i, r := range prefix {
+		if s[i] != r {
+			return false
+		}
+	}
+	return true
+}
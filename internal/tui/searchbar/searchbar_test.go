@@ -0,0 +1,83 @@
+package searchbar
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Eanhain/gophkeeper-client/contracts/response"
+)
+
+// mark brackets matches so the output does not depend on the color profile.
+var mark = lipgloss.NewStyle().Transform(func(s string) string { return "[" + s + "]" })
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		query string
+		want  string
+	}{
+		{"empty query", "github", "", "github"},
+		{"no match", "github", "gitlab", "github"},
+		{"single", "my github login", "git", "my [git]hub login"},
+		{"multiple", "git and git and git", "git", "[git] and [git] and [git]"},
+		{"adjacent", "aaaa", "aa", "[aa][aa]"},
+		{"case insensitive", "GitHub github GITHUB", "github", "[GitHub] [github] [GITHUB]"},
+		{"query longer than text", "go", "golang", "go"},
+		{"cyrillic", "Привет мир привет", "ПРИВЕТ", "[Привет] мир [привет]"},
+		{"emoji", "🔑key🔑key", "key", "🔑[key]🔑[key]"},
+		{"width-changing fold", "İstanbul istanbul", "i", "[İ]stanbul [i]stanbul"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Highlight(tt.text, tt.query, mark); got != tt.want {
+				t.Errorf("Highlight(%q, %q) = %q, want %q", tt.text, tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		text, query string
+		want        bool
+	}{
+		{"GitHub", "hub", true},
+		{"GitHub", "", true},
+		{"Привет", "ВЕТ", true},
+		{"GitHub", "lab", false},
+		{"go", "golang", false},
+	}
+	for _, tt := range tests {
+		if got := Contains(tt.text, tt.query); got != tt.want {
+			t.Errorf("Contains(%q, %q) = %v, want %v", tt.text, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestCompletions(t *testing.T) {
+	secrets := &response.AllSecrets{
+		LoginPassword: []response.LoginPassword{{Label: "GitHub"}, {Label: "gitlab"}, {Label: ""}},
+		TextSecret:    []response.TextSecret{{Title: "github"}, {Title: "notes"}},
+		BinarySecret:  []response.BinarySecret{{Filename: "GitHub"}},
+		CardSecret:    []response.CardSecret{{Cardholder: "Gina"}},
+	}
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"git", []string{"GitHub", "github", "gitlab"}},
+		{"GI", []string{"Gina", "GitHub", "github", "gitlab"}},
+		{"x", nil},
+	}
+	for _, tt := range tests {
+		if got := Completions(secrets, tt.prefix); !slices.Equal(got, tt.want) {
+			t.Errorf("Completions(%q) = %q, want %q", tt.prefix, got, tt.want)
+		}
+	}
+	if got := Completions(nil, "g"); got != nil {
+		t.Errorf("Completions(nil) = %q, want nil", got)
+	}
+}
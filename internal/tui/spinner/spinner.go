@@ -0,0 +1,68 @@
+// Package spinner implements a TUI loading indicator with a message.
+package spinner
+
+import (
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Eanhain/gophkeeper-client/internal/tui/theme"
+)
+
+// Loading messages for the common operations.
+const (
+	MsgFetching       = "Fetching secrets..."
+	MsgAuthenticating = "Authenticating..."
+	MsgSaving         = "Saving..."
+)
+
+// Model is a spinner with a loading message.
+type Model struct {
+	spinner spinner.Model
+	message string
+	active  bool
+}
+
+// New returns an inactive spinner.
+func New() Model {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Current().Title))
+	return Model{spinner: s}
+}
+
+// Start activates the spinner with message and returns the first tick.
+func (m Model) Start(message string) (Model, tea.Cmd) {
+	m.message = message
+	m.active = true
+	return m, m.spinner.Tick
+}
+
+// Stop deactivates the spinner; pending ticks are then ignored.
+func (m Model) Stop() Model {
+	m.active = false
+	return m
+}
+
+// Active reports whether the spinner is running.
+func (m Model) Active() bool {
+	return m.active
+}
+
+// Update advances the spinner on its tick messages while active.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.active {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return m, cmd
+}
+
+// View renders the spinner and message, or nothing when inactive.
+func (m Model) View() string {
+	if !m.active {
+		return ""
+	}
+	return "\n  " + m.spinner.View() + " " + m.message + "\n"
+}
@@ -0,0 +1,90 @@
+// Package statusbar implements the full-width TUI status bar showing the
+// username, connection status and local time.
+package statusbar
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Eanhain/gophkeeper-client/internal/tui/theme"
+)
+
+var (
+	barStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("252")).Background(lipgloss.Color("236"))
+	offlineStyle = barStyle.Foreground(lipgloss.Color("214"))
+)
+
+// ClockMsg carries the current time to Update.
+type ClockMsg time.Time
+
+// Model is a status bar state.
+type Model struct {
+	username string
+	status   string
+	online   bool
+	clock    time.Time
+}
+
+// New returns a status bar with the clock set to now.
+func New() Model {
+	return Model{clock: time.Now()}
+}
+
+// Tick schedules the next ClockMsg in a second.
+func Tick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return ClockMsg(t)
+	})
+}
+
+// Update refreshes the clock on ClockMsg and schedules the next tick.
+func (sb Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if t, ok := msg.(ClockMsg); ok {
+		sb.clock = time.Time(t)
+		return sb, Tick()
+	}
+	return sb, nil
+}
+
+// SetUser sets the logged in username.
+func (sb Model) SetUser(username string) Model {
+	sb.username = username
+	return sb
+}
+
+// SetOnline sets the connection state and an optional status text shown next to it.
+func (sb Model) SetOnline(online bool, status string) Model {
+	sb.online = online
+	sb.status = status
+	return sb
+}
+
+// View renders the bar across width columns.
+func (sb Model) View(width int) string {
+	left := " " + sb.username
+	if sb.username == "" {
+		left = " not logged in"
+	}
+
+	center := offlineStyle.Render("○ offline")
+	if sb.online {
+		center = barStyle.Foreground(lipgloss.Color(theme.Current().OK)).Render("● online")
+	}
+	if sb.status != "" {
+		center += barStyle.Render(" · " + sb.status)
+	}
+
+	right := sb.clock.Format("15:04:05") + " "
+
+	gap := width - lipgloss.Width(left) - lipgloss.Width(center) - lipgloss.Width(right)
+	if gap < 2 {
+		return barStyle.Width(width).MaxWidth(width).Render(left + " " + right)
+	}
+	leftGap := gap / 2
+	return barStyle.Render(left+strings.Repeat(" ", leftGap)) +
+		center +
+		barStyle.Render(strings.Repeat(" ", gap-leftGap)+right)
+}
@@ -0,0 +1,194 @@
+// Package table implements a selectable TUI table with a fixed header and
+// column widths adapted to content and terminal width.
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Eanhain/gophkeeper-client/internal/tui/theme"
+)
+
+// cellPadding is the horizontal padding bubbles/table adds around each cell.
+const cellPadding = 2
+
+// Column defines a table column. MinWidth is the narrowest the column is
+// shrunk to when the table does not fit; zero means the title width.
+type Column struct {
+	Title    string
+	MinWidth int
+}
+
+// Model is a table state.
+type Model struct {
+	inner   table.Model
+	columns []Column
+	rows    [][]string
+	width   int
+}
+
+// New returns a focused table with widths fitted to the content.
+func New(columns []Column, rows [][]string) Model {
+	styles := table.DefaultStyles()
+	styles.Header = styles.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderBottom(true).
+		Bold(true)
+	styles.Selected = styles.Selected.Foreground(lipgloss.Color(theme.Current().Selected)).Bold(true)
+
+	m := Model{
+		inner:   table.New(table.WithFocused(true), table.WithStyles(styles)),
+		columns: columns,
+	}
+	return m.SetRows(rows)
+}
+
+// SetRows replaces the table rows and recomputes column widths.
+func (m Model) SetRows(rows [][]string) Model {
+	m.rows = rows
+	return m.resize()
+}
+
+// SetSize sets the available width and height and recomputes column widths.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.inner.SetHeight(height)
+	return m.resize()
+}
+
+// Update handles window resizes and cursor keys.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		m.width = msg.Width
+		return m.resize(), nil
+	}
+	var cmd tea.Cmd
+	m.inner, cmd = m.inner.Update(msg)
+	return m, cmd
+}
+
+// View renders the table.
+func (m Model) View() string {
+	return m.inner.View()
+}
+
+// Cursor returns the selected row index.
+func (m Model) Cursor() int {
+	return m.inner.Cursor()
+}
+
+// SelectedRow returns the selected row or nil when the table is empty.
+func (m Model) SelectedRow() []string {
+	return m.inner.SelectedRow()
+}
+
+// Focus enables keyboard navigation.
+func (m *Model) Focus() {
+	m.inner.Focus()
+}
+
+// Blur disables keyboard navigation.
+func (m *Model) Blur() {
+	m.inner.Blur()
+}
+
+func (m Model) resize() Model {
+	natural := make([]int, len(m.columns))
+	minimum := make([]int, len(m.columns))
+	for i, c := range m.columns {
+		natural[i] = lipgloss.Width(c.Title)
+		minimum[i] = c.MinWidth
+		if minimum[i] == 0 {
+			minimum[i] = natural[i]
+		}
+	}
+	for _, row := range m.rows {
+		for i := range m.columns {
+			if i < len(row) {
+				natural[i] = max(natural[i], lipgloss.Width(row[i]))
+			}
+		}
+	}
+
+	widths := natural
+	if m.width > 0 {
+		widths = FitWidths(natural, minimum, m.width-cellPadding*len(m.columns))
+	}
+
+	cols := make([]table.Column, len(m.columns))
+	for i, c := range m.columns {
+		cols[i] = table.Column{Title: c.Title, Width: widths[i]}
+	}
+	rows := make([]table.Row, len(m.rows))
+	for i, r := range m.rows {
+		rows[i] = table.Row(r)
+	}
+	// Rows must be cleared first: bubbles/table renders rows against the current columns.
+	m.inner.SetRows(nil)
+	m.inner.SetColumns(cols)
+	m.inner.SetRows(rows)
+	return m
+}
+
+// FitWidths scales natural column widths down proportionally so that their sum
+// does not exceed maxTotal, keeping each column at least its minimum width.
+func FitWidths(natural, minimum []int, maxTotal int) []int {
+	widths := make([]int, len(natural))
+	copy(widths, natural)
+
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	if total <= maxTotal || total == 0 {
+		return widths
+	}
+
+	shrinkable, excess := 0, total-maxTotal
+	for i, w := range widths {
+		shrinkable += max(w-minimum[i], 0)
+	}
+	if shrinkable == 0 {
+		return widths
+	}
+	// Minimums win when the columns cannot fit at all.
+	excess = min(excess, shrinkable)
+
+	removed := 0
+	for i, w := range widths {
+		cut := max(w-minimum[i], 0) * excess / shrinkable
+		widths[i] -= cut
+		removed += cut
+	}
+	// Integer division leaves a remainder; take it from the widest shrinkable columns.
+	for removed < excess {
+		widest := -1
+		for i, w := range widths {
+			if w > minimum[i] && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+		removed++
+	}
+	return widths
+}
+
+// ComputeColWidths returns, for each getter, the widest rendered value across
+// items, scaled down proportionally when the sum exceeds maxTotal.
+// Columns never shrink below one cell.
+func ComputeColWidths[T any](items []T, getters []func(T) string, maxTotal int) []int {
+	natural := make([]int, len(getters))
+	minimum := make([]int, len(getters))
+	for i, get := range getters {
+		minimum[i] = 1
+		for _, item := range items {
+			natural[i] = max(natural[i], lipgloss.Width(get(item)))
+		}
+	}
+	return FitWidths(natural, minimum, maxTotal)
+}
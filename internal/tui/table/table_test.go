@@ -0,0 +1,70 @@
+package table
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFitWidths(t *testing.T) {
+	tests := []struct {
+		name     string
+		natural  []int
+		minimum  []int
+		maxTotal int
+		want     []int
+	}{
+		{"fits", []int{10, 20}, []int{5, 5}, 40, []int{10, 20}},
+		{"exact", []int{10, 20}, []int{5, 5}, 30, []int{10, 20}},
+		{"proportional", []int{10, 50, 5}, []int{5, 5, 5}, 40, []int{8, 27, 5}},
+		{"nothing shrinkable", []int{5, 5}, []int{5, 5}, 4, []int{5, 5}},
+		{"below minimums", []int{10, 10}, []int{5, 5}, 4, []int{5, 5}},
+		{"empty", nil, nil, 10, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FitWidths(tt.natural, tt.minimum, tt.maxTotal)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FitWidths(%v, %v, %d) = %v, want %v", tt.natural, tt.minimum, tt.maxTotal, got, tt.want)
+			}
+			for i, w := range got {
+				if w < tt.minimum[i] {
+					t.Errorf("column %d width %d below minimum %d", i, w, tt.minimum[i])
+				}
+			}
+		})
+	}
+}
+
+func TestComputeColWidths(t *testing.T) {
+	type row struct{ label, login string }
+	items := []row{
+		{"GitHub", "octocat"},
+		{"Почта", "me@example.com"},
+		{"🔑 vault", "x"},
+	}
+	getters := []func(row) string{
+		func(r row) string { return r.label },
+		func(r row) string { return r.login },
+	}
+
+	tests := []struct {
+		name     string
+		items    []row
+		maxTotal int
+		want     []int
+	}{
+		{"natural", items, 100, []int{8, 14}},
+		{"exact", items, 22, []int{8, 14}},
+		{"shrunk", items, 11, []int{5, 6}},
+		{"never below one", items, 1, []int{1, 1}},
+		{"no items", nil, 10, []int{0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ComputeColWidths(tt.items, getters, tt.maxTotal)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ComputeColWidths(maxTotal=%d) = %v, want %v", tt.maxTotal, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Package theme holds the TUI color theme and the styles built from it.
+package theme
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+//go:embed themes.toml
+var builtinThemes []byte
+
+// DefaultName is the theme used when none is configured, on a dark
+// background. LightSuffix marks the variant for light backgrounds.
+const (
+	DefaultName = "default"
+	LightSuffix = "-light"
+)
+
+// hasDarkBackground queries the terminal; tests replace it.
+var hasDarkBackground = lipgloss.HasDarkBackground
+
+// Theme holds the TUI colors as lipgloss colors: ANSI 256 numbers or "#rrggbb".
+type Theme struct {
+	Title    string `toml:"title"`
+	Selected string `toml:"selected"`
+	Normal   string `toml:"normal"`
+	Error    string `toml:"error"`
+	OK       string `toml:"ok"`
+	Help     string `toml:"help"`
+	SecTitle string `toml:"secTitle"`
+	SecItem  string `toml:"secItem"`
+}
+
+// Styles built from the applied theme. Apply reassigns them, so components
+// read them when rendering rather than copying them at init.
+var (
+	Title    lipgloss.Style
+	Selected lipgloss.Style
+	Normal   lipgloss.Style
+	Error    lipgloss.Style
+	OK       lipgloss.Style
+	Help     lipgloss.Style
+	SecTitle lipgloss.Style
+	SecItem  lipgloss.Style
+
+	current Theme
+)
+
+func init() {
+	themes, err := parse(builtinThemes)
+	if err != nil {
+		panic(fmt.Sprintf("theme: built-in themes: %v", err))
+	}
+	Apply(themes[DefaultName])
+}
+
+// Load returns the theme called name. Themes in the TOML file at path, when
+// path is not empty, replace built-in themes of the same name; colors they
+// leave out fall back to the default theme.
+func Load(path, name string) (Theme, error) {
+	themes, err := parse(builtinThemes)
+	if err != nil {
+		return Theme{}, fmt.Errorf("theme: built-in themes: %w", err)
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Theme{}, fmt.Errorf("theme: %w", err)
+		}
+		custom, err := parse(data)
+		if err != nil {
+			return Theme{}, fmt.Errorf("theme: %s: %w", path, err)
+		}
+		for n, t := range custom {
+			themes[n] = t.withDefaults(themes[DefaultName])
+		}
+	}
+
+	t, ok := themes[name]
+	if !ok {
+		return Theme{}, fmt.Errorf("theme: unknown theme %q", name)
+	}
+	return t, nil
+}
+
+// Resolve returns the theme to use for name. An empty name picks the default
+// theme for a dark background or its light variant otherwise; a configured
+// name is used as is, so --theme overrides the detection.
+func Resolve(name string, dark bool) string {
+	switch {
+	case name != "":
+		return name
+	case dark:
+		return DefaultName
+	default:
+		return DefaultName + LightSuffix
+	}
+}
+
+// Setup loads the theme called name, with the themes file at path, and
+// applies it. An empty name detects the terminal background, see Resolve.
+// With noColor set, all styling is turned off instead, as asked for by
+// NO_COLOR. Call it once at startup, before the TUI components are created.
+func Setup(name, path string, noColor bool) error {
+	if name == "" {
+		name = Resolve(name, hasDarkBackground())
+	}
+	t, err := Load(path, name)
+	if err != nil {
+		return err
+	}
+	if noColor {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	Apply(t)
+	return nil
+}
+
+// Apply rebuilds the styles from t. Call it at startup, before the TUI
+// components are created.
+func Apply(t Theme) {
+	current = t
+	Title = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(t.Title))
+	Selected = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(t.Selected))
+	Normal = lipgloss.NewStyle().Foreground(lipgloss.Color(t.Normal))
+	Error = lipgloss.NewStyle().Foreground(lipgloss.Color(t.Error))
+	OK = lipgloss.NewStyle().Foreground(lipgloss.Color(t.OK))
+	Help = lipgloss.NewStyle().Foreground(lipgloss.Color(t.Help))
+	SecTitle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(t.SecTitle))
+	SecItem = lipgloss.NewStyle().Foreground(lipgloss.Color(t.SecItem))
+}
+
+// Current returns the applied theme.
+func Current() Theme {
+	return current
+}
+
+func parse(data []byte) (map[string]Theme, error) {
+	themes := make(map[string]Theme)
+	if _, err := toml.Decode(string(data), &themes); err != nil {
+		return nil, err
+	}
+	return themes, nil
+}
+
+func (t Theme) withDefaults(d Theme) Theme {
+	fill := func(v *string, def string) {
+		if *v == "" {
+			*v = def
+		}
+	}
+	fill(&t.Title, d.Title)
+	fill(&t.Selected, d.Selected)
+	fill(&t.Normal, d.Normal)
+	fill(&t.Error, d.Error)
+	fill(&t.OK, d.OK)
+	fill(&t.Help, d.Help)
+	fill(&t.SecTitle, d.SecTitle)
+	fill(&t.SecItem, d.SecItem)
+	return t
+}
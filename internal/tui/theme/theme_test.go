@@ -0,0 +1,144 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestSetup(t *testing.T) {
+	t.Cleanup(func() { Apply(mustLoad(t, "", DefaultName)) })
+
+	if err := Setup("nord", "", false); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	if got, want := Current(), mustLoad(t, "", "nord"); got != want {
+		t.Errorf("Current() = %+v, want %+v", got, want)
+	}
+
+	before := Current()
+	if err := Setup("neon", "", false); err == nil {
+		t.Fatal("Setup: expected error for unknown theme")
+	}
+	if Current() != before {
+		t.Error("failed Setup changed the applied theme")
+	}
+}
+
+func TestLoadThemesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "themes.toml")
+	data := "[mine]\ntitle = \"#ffffff\"\n\n[nord]\nerror = \"1\"\n"
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	def := mustLoad(t, "", DefaultName)
+
+	mine := mustLoad(t, path, "mine")
+	if mine.Title != "#ffffff" || mine.Error != def.Error {
+		t.Errorf("mine = %+v, want title #ffffff and default error %s", mine, def.Error)
+	}
+	nord := mustLoad(t, path, "nord")
+	if nord.Error != "1" || nord.Title != def.Title {
+		t.Errorf("nord = %+v, want error 1 and default title %s", nord, def.Title)
+	}
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.toml"), DefaultName); err == nil {
+		t.Error("Load: expected error for a missing themes file")
+	}
+}
+
+func mustLoad(t *testing.T, path, name string) Theme {
+	t.Helper()
+	th, err := Load(path, name)
+	if err != nil {
+		t.Fatalf("Load(%q, %q): %v", path, name, err)
+	}
+	return th
+}
+
+func TestSetupNoColor(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(profile)
+		Apply(mustLoad(t, "", DefaultName))
+	})
+
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	Apply(mustLoad(t, "", DefaultName))
+	if got := Title.Render("x"); !strings.Contains(got, "\x1b[") {
+		t.Fatalf("Title.Render = %q, want escape codes before Setup", got)
+	}
+
+	if err := Setup(DefaultName, "", true); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	styles := []lipgloss.Style{Title, Selected, Normal, Error, OK, Help, SecTitle, SecItem}
+	for i, s := range styles {
+		if got := s.Render("x"); got != "x" {
+			t.Errorf("style %d renders %q, want %q", i, got, "x")
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name string
+		dark bool
+		want string
+	}{
+		{"", true, "default"},
+		{"", false, "default-light"},
+		{"nord", true, "nord"},
+		{"nord", false, "nord"},
+		{"solarized-light", true, "solarized-light"},
+	}
+	for _, tt := range tests {
+		if got := Resolve(tt.name, tt.dark); got != tt.want {
+			t.Errorf("Resolve(%q, %v) = %q, want %q", tt.name, tt.dark, got, tt.want)
+		}
+	}
+}
+
+func TestSetupDetectsBackground(t *testing.T) {
+	detect := hasDarkBackground
+	t.Cleanup(func() {
+		hasDarkBackground = detect
+		Apply(mustLoad(t, "", DefaultName))
+	})
+
+	tests := []struct {
+		name   string
+		theme  string
+		dark   bool
+		want   string
+		detect bool
+	}{
+		{"dark background", "", true, "default", true},
+		{"light background", "", false, "default-light", true},
+		{"configured theme", "monokai", false, "monokai", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detected := false
+			hasDarkBackground = func() bool {
+				detected = true
+				return tt.dark
+			}
+			if err := Setup(tt.theme, "", false); err != nil {
+				t.Fatalf("Setup: %v", err)
+			}
+			if got, want := Current(), mustLoad(t, "", tt.want); got != want {
+				t.Errorf("Current() = %+v, want %s %+v", got, tt.want, want)
+			}
+			if detected != tt.detect {
+				t.Errorf("background detected = %v, want %v", detected, tt.detect)
+			}
+		})
+	}
+	if mustLoad(t, "", "default") == mustLoad(t, "", "default-light") {
+		t.Error("default and default-light themes are the same")
+	}
+}
@@ -0,0 +1,33 @@
+// Package timeago formats TUI timestamps relative to the current time.
+package timeago
+
+import (
+	"fmt"
+	"time"
+)
+
+// Format describes t relative to now: "just now" under a minute, then
+// "N minutes ago", "N hours ago" and "N days ago" up to a week. Older and
+// future times are formatted with layout, normally cfg.UI.DateFormat.
+func Format(t, now time.Time, layout string) string {
+	d := now.Sub(t)
+	switch {
+	case d < 0 || d >= 7*24*time.Hour:
+		return t.Format(layout)
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return ago(int(d/time.Minute), "minute")
+	case d < 24*time.Hour:
+		return ago(int(d/time.Hour), "hour")
+	default:
+		return ago(int(d/(24*time.Hour)), "day")
+	}
+}
+
+func ago(n int, unit string) string {
+	if n != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s ago", n, unit)
+}
@@ -0,0 +1,35 @@
+package timeago
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	const layout = "02.01.2006 15:04"
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{"same instant", now, "just now"},
+		{"seconds", now.Add(-59 * time.Second), "just now"},
+		{"one minute", now.Add(-time.Minute), "1 minute ago"},
+		{"minutes", now.Add(-45 * time.Minute), "45 minutes ago"},
+		{"one hour", now.Add(-time.Hour), "1 hour ago"},
+		{"hours rounded down", now.Add(-(5*time.Hour + 59*time.Minute)), "5 hours ago"},
+		{"one day", now.Add(-24 * time.Hour), "1 day ago"},
+		{"two days", now.Add(-50 * time.Hour), "2 days ago"},
+		{"six days", now.Add(-6 * 24 * time.Hour), "6 days ago"},
+		{"a week uses layout", now.Add(-7 * 24 * time.Hour), "08.03.2024 12:00"},
+		{"future uses layout", now.Add(time.Hour), "15.03.2024 13:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.t, now, layout); got != tt.want {
+				t.Errorf("Format(%v) = %q, want %q", tt.t, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Package wrap breaks long plain-text TUI messages into lines that fit the terminal.
+package wrap
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WordWrap breaks s at word boundaries so that no line is wider than width
+// terminal cells, counting e.g. CJK characters as two. Runs of spaces collapse
+// to one, existing line breaks are kept and words wider than width are split;
+// a single character wider than width gets a line of its own. A width below
+// one returns s unchanged.
+func WordWrap(s string, width int) string {
+	if width < 1 {
+		return s
+	}
+	var lines []string
+	for _, para := range strings.Split(s, "\n") {
+		lines = append(lines, wrapLine(para, width)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Prefixed wraps s to width with prefix (e.g. "! ") on the first line and
+// continuation lines indented to align with the text after it.
+func Prefixed(prefix, s string, width int) string {
+	indent := strings.Repeat(" ", lipgloss.Width(prefix))
+	wrapped := WordWrap(s, width-len(indent))
+	return prefix + strings.ReplaceAll(wrapped, "\n", "\n"+indent)
+}
+
+func wrapLine(s string, width int) []string {
+	var lines []string
+	var line string
+	lineWidth := 0
+	for _, word := range strings.Fields(s) {
+		w := lipgloss.Width(word)
+		if line != "" && lineWidth+1+w <= width {
+			line += " " + word
+			lineWidth += 1 + w
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+		for w > width {
+			head, rest := splitWidth(word, width)
+			if rest == "" {
+				break // a single character wider than width
+			}
+			lines = append(lines, head)
+			word, w = rest, lipgloss.Width(rest)
+		}
+		line, lineWidth = word, w
+	}
+	return append(lines, line)
+}
+
+// splitWidth splits s after the most runes that fit in width cells, but after
+// at least one rune so that a character wider than width still makes progress.
+func splitWidth(s string, width int) (string, string) {
+	used := 0
+	for i, r := range s {
+		w := lipgloss.Width(string(r))
+		if i > 0 && used+w > width {
+			return s[:i], s[i:]
+		}
+		used += w
+	}
+	return s, ""
+}
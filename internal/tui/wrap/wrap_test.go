@@ -0,0 +1,53 @@
+package wrap
+
+import "testing"
+
+func TestWordWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{"shorter than width", "login failed", 20, "login failed"},
+		{"exactly width", "login failed", 12, "login failed"},
+		{"longer than width", "the server rejected the request", 12, "the server\nrejected the\nrequest"},
+		{"long word split", "token abcdefghij", 4, "toke\nn\nabcd\nefgh\nij"},
+		{"spaces collapse", "a   b    c", 3, "a b\nc"},
+		{"line breaks kept", "first line\n\nsecond", 20, "first line\n\nsecond"},
+		{"cyrillic one cell per letter", "пароль неверный", 6, "пароль\nневерн\nый"},
+		{"double-width split by cells", "日本語のテキスト", 6, "日本語\nのテキ\nスト"},
+		{"double-width words", "ok 日本 go", 5, "ok\n日本\ngo"},
+		{"double-width wider than width", "日本", 1, "日\n本"},
+		{"empty", "", 10, ""},
+		{"zero width", "unchanged text", 0, "unchanged text"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WordWrap(tt.s, tt.width); got != tt.want {
+				t.Errorf("WordWrap(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefixed(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		s      string
+		width  int
+		want   string
+	}{
+		{"shorter than width", "! ", "login failed", 20, "! login failed"},
+		{"longer than width", "! ", "the server rejected the request", 14, "! the server\n  rejected the\n  request"},
+		{"double-width prefix", "错 ", "the server rejected", 13, "错 the server\n   rejected"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Prefixed(tt.prefix, tt.s, tt.width); got != tt.want {
+				t.Errorf("Prefixed(%q, %q, %d) = %q, want %q", tt.prefix, tt.s, tt.width, got, tt.want)
+			}
+		})
+	}
+}
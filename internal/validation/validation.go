@@ -0,0 +1,160 @@
+// Package validation checks secret fields before they are sent to the server.
+package validation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExpYearSpan is how many years ahead of the current one a card may expire.
+const ExpYearSpan = 20
+
+const mb = 1024 * 1024
+
+const mimeToken = "[!#$%&'*+\\-.^_`{|}~0-9A-Za-z]+"
+
+var (
+	emailRe    = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	digitsRe   = regexp.MustCompile(`^[0-9]+$`)
+	expMonthRe = regexp.MustCompile(`^(0[1-9]|1[0-2])$`)
+	expYearRe  = regexp.MustCompile(`^[0-9]{4}$`)
+	cvvRe      = regexp.MustCompile(`^[0-9]{3,4}$`)
+	// RFC 2045: type "/" subtype *(";" parameter), tokens exclude tspecials.
+	mimeTypeRe = regexp.MustCompile(`^` + mimeToken + `/` + mimeToken +
+		`(\s*;\s*` + mimeToken + `=(` + mimeToken + `|"[^"]*"))*$`)
+)
+
+// ValidationError describes an invalid field.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+func newError(field, message string) *ValidationError {
+	return &ValidationError{Field: field, Message: message}
+}
+
+// Required checks that value is not empty.
+func Required(field, value string) error {
+	if value == "" {
+		return newError(field, "is required")
+	}
+	return nil
+}
+
+// Login checks that login is a valid email when it contains "@".
+func Login(field, value string) error {
+	if err := Required(field, value); err != nil {
+		return err
+	}
+	if strings.Contains(value, "@") && !emailRe.MatchString(value) {
+		return newError(field, "invalid email format")
+	}
+	return nil
+}
+
+// Base64 checks that value is standard base64.
+func Base64(field, value string) error {
+	if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+		return newError(field, "invalid base64 data")
+	}
+	return nil
+}
+
+// MimeType checks that a non-empty value has RFC 2045 type/subtype format.
+func MimeType(field, value string) error {
+	if value != "" && !mimeTypeRe.MatchString(value) {
+		return newError(field, "invalid MIME type")
+	}
+	return nil
+}
+
+// PAN checks that value is digits only, 12-19 long, and passes the Luhn check.
+func PAN(field, value string) error {
+	if !digitsRe.MatchString(value) {
+		return newError(field, "must contain digits only")
+	}
+	if len(value) < 12 || len(value) > 19 {
+		return newError(field, "must be 12 to 19 digits long")
+	}
+	if !luhn(value) {
+		return newError(field, "failed Luhn check")
+	}
+	return nil
+}
+
+// ExpMonth checks that value is a two-digit month 01-12.
+func ExpMonth(field, value string) error {
+	if !expMonthRe.MatchString(value) {
+		return newError(field, "must be 01-12")
+	}
+	return nil
+}
+
+// ExpYear checks that value is a four-digit year from the current one to ExpYearSpan years ahead.
+func ExpYear(field, value string) error {
+	current := time.Now().Year()
+	msg := fmt.Sprintf("must be %d-%d", current, current+ExpYearSpan)
+	if !expYearRe.MatchString(value) {
+		return newError(field, msg)
+	}
+	year, _ := strconv.Atoi(value)
+	if year < current || year > current+ExpYearSpan {
+		return newError(field, msg)
+	}
+	return nil
+}
+
+// CVV checks that value is 3 or 4 digits. The CVV is only checked locally:
+// it is not part of request.CardSecret and is never sent or stored (PCI DSS).
+func CVV(field, value string) error {
+	if !cvvRe.MatchString(value) {
+		return newError(field, "must be 3 or 4 digits")
+	}
+	return nil
+}
+
+func luhn(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// ErrBinaryTooLarge is returned when decoded binary data exceeds the size limit.
+type ErrBinaryTooLarge struct {
+	Limit  int64
+	Actual int64
+}
+
+func (e *ErrBinaryTooLarge) Error() string {
+	return fmt.Sprintf("file exceeds %d MB limit (was %d MB)", e.Limit/mb, (e.Actual+mb-1)/mb)
+}
+
+// BinarySize checks that base64 data decodes to at most limitMB megabytes.
+func BinarySize(data string, limitMB int64) error {
+	limit := limitMB * mb
+	actual := int64(base64.StdEncoding.DecodedLen(len(data)))
+	if actual > limit {
+		return &ErrBinaryTooLarge{Limit: limit, Actual: actual}
+	}
+	return nil
+}